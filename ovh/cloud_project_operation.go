@@ -0,0 +1,59 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/ovh/go-ovh/ovh"
+)
+
+// waitForCloudProjectOperation waits for a public cloud operation, e.g. returned when creating
+// a resource through the region endpoints, to be completed
+func waitForCloudProjectOperation(c *ovh.Client, serviceName, operationId string, timeout time.Duration) (*CloudProjectOperationResponse, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"created", "in-progress"},
+		Target:     []string{"completed"},
+		Refresh:    waitForCloudProjectOperationCheck(c, serviceName, operationId),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	res, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, fmt.Errorf("waiting for cloud project operation %s/%s: %s", serviceName, operationId, err)
+	}
+
+	op, ok := res.(*CloudProjectOperationResponse)
+	if !ok {
+		return nil, fmt.Errorf(
+			"Error waiting for operation %s/%s: got %v instead of CloudProjectOperationResponse",
+			serviceName,
+			operationId,
+			reflect.TypeOf(res),
+		)
+	}
+
+	return op, nil
+}
+
+func waitForCloudProjectOperationCheck(c *ovh.Client, serviceName, operationId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res := &CloudProjectOperationResponse{}
+		endpoint := fmt.Sprintf("/cloud/project/%s/operation/%s",
+			url.PathEscape(serviceName),
+			url.PathEscape(operationId))
+
+		if err := c.Get(endpoint, res); err != nil {
+			log.Printf("[WARNING] error while waiting for cloud project operation id %s: %v", operationId, err)
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] Pending cloud project operation: %+v", res)
+		return res, res.Status, nil
+	}
+}
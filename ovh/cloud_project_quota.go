@@ -0,0 +1,52 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func getCloudProjectQuotas(c *ovh.Client, serviceName string) ([]CloudProjectQuotaResponse, error) {
+	endpoint := fmt.Sprintf("/cloud/project/%s/quota", url.PathEscape(serviceName))
+	var res []CloudProjectQuotaResponse
+
+	log.Printf("[DEBUG] Will read quotas of project %s", serviceName)
+	if err := c.Get(endpoint, &res); err != nil {
+		return nil, fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+	return res, nil
+}
+
+// checkCloudProjectQuota returns an error when the request exceeds the quotas left in the region
+func checkCloudProjectQuota(c *ovh.Client, serviceName, region string, req CloudProjectQuotaRequest) error {
+	quotas, err := getCloudProjectQuotas(c, serviceName)
+	if err != nil {
+		return fmt.Errorf("unable to check the quotas of project %s: %w", serviceName, err)
+	}
+
+	for i := range quotas {
+		if quotas[i].Region != region {
+			continue
+		}
+		if exceeded := req.Exceeded(&quotas[i]); len(exceeded) > 0 {
+			return fmt.Errorf("quotas of project %s exceeded, raise them before the apply:\n\t %s",
+				serviceName, strings.Join(exceeded, "\n\t "))
+		}
+		return nil
+	}
+	return nil
+}
+
+// reportCloudProjectQuota fails the plan with the result of a quota check when check_quota is true.
+// Otherwise the result is only logged as a warning, as the SDK can't add warnings to a plan.
+func reportCloudProjectQuota(d *schema.ResourceDiff, err error) error {
+	if err == nil || d.Get("check_quota").(bool) {
+		return err
+	}
+	log.Printf("[WARN] %s", err)
+	return nil
+}
@@ -0,0 +1,228 @@
+package ovh
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// harborClient calls the Harbor API of a managed private registry
+// with the credentials of one of its users
+type harborClient struct {
+	ctx      context.Context
+	endpoint string
+	user     string
+	password string
+	client   *http.Client
+}
+
+// HarborAPIError is returned when the Harbor API answers with an error status
+type HarborAPIError struct {
+	Code    int
+	Method  string
+	Path    string
+	Message string
+}
+
+func (e *HarborAPIError) Error() string {
+	return fmt.Sprintf("Error %d calling %s %s: %q", e.Code, e.Method, e.Path, e.Message)
+}
+
+func isHarborNotFound(err error) bool {
+	errHarbor, ok := err.(*HarborAPIError)
+	return ok && errHarbor.Code == http.StatusNotFound
+}
+
+const harborLogSubsystem = "Harbor"
+
+// harborSecretRegexp matches the secret of a robot account in the logged bodies
+var harborSecretRegexp = regexp.MustCompile(`"secret"\s*:\s*"(?:[^"\\]|\\.)*"`)
+
+// harborLoggingContext masks the credentials of the registry user and the secrets
+// of the robot accounts in the requests and responses logged by the client
+func harborLoggingContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, harborLogSubsystem)
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, harborLogSubsystem, "Authorization")
+	return tflog.SubsystemMaskAllFieldValuesRegexes(ctx, harborLogSubsystem, harborSecretRegexp)
+}
+
+// newHarborClient returns a client for the registry url, as exported by
+// ovh_cloud_project_containerregistry, with or without its scheme
+func newHarborClient(ctx context.Context, registryUrl, user, password string) *harborClient {
+	endpoint := strings.TrimSuffix(registryUrl, "/")
+	if !strings.HasPrefix(endpoint, "http://") && !strings.HasPrefix(endpoint, "https://") {
+		endpoint = "https://" + endpoint
+	}
+
+	return &harborClient{
+		ctx:      harborLoggingContext(ctx),
+		endpoint: endpoint + "/api/v2.0",
+		user:     user,
+		password: password,
+		client: &http.Client{
+			Transport: logging.NewSubsystemLoggingHTTPTransport(harborLogSubsystem, cleanhttp.DefaultTransport()),
+			Timeout:   60 * time.Second,
+		},
+	}
+}
+
+func (c *harborClient) Get(path string, result interface{}) error {
+	_, err := c.call(http.MethodGet, path, nil, result)
+	return err
+}
+
+// Post creates an object and returns the id found in the Location header of the response
+func (c *harborClient) Post(path string, body interface{}) (int64, error) {
+	header, err := c.call(http.MethodPost, path, body, nil)
+	if err != nil {
+		return 0, err
+	}
+	return harborIdFromLocation(header.Get("Location"))
+}
+
+func (c *harborClient) Put(path string, body interface{}) error {
+	_, err := c.call(http.MethodPut, path, body, nil)
+	return err
+}
+
+func (c *harborClient) Delete(path string) error {
+	_, err := c.call(http.MethodDelete, path, nil, nil)
+	return err
+}
+
+func (c *harborClient) call(method, path string, body, result interface{}) (http.Header, error) {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("marshalling body of %s %s: %w", method, path, err)
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(c.ctx, method, c.endpoint+path, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.user, c.password)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "Terraform/"+providerVersion+"/"+providerCommit)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 300 {
+		apiErr := &HarborAPIError{Code: resp.StatusCode, Method: method, Path: path, Message: string(respBody)}
+		errs := struct {
+			Errors []struct {
+				Message string `json:"message"`
+			} `json:"errors"`
+		}{}
+		if json.Unmarshal(respBody, &errs) == nil && len(errs.Errors) > 0 {
+			apiErr.Message = errs.Errors[0].Message
+		}
+		return nil, apiErr
+	}
+
+	if result != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return nil, fmt.Errorf("unmarshalling response of %s %s: %w", method, path, err)
+		}
+	}
+
+	return resp.Header, nil
+}
+
+func harborIdFromLocation(location string) (int64, error) {
+	id, err := strconv.ParseInt(path.Base(location), 10, 64)
+	if location == "" || err != nil {
+		return 0, fmt.Errorf("no id found in location %q returned by Harbor", location)
+	}
+	return id, nil
+}
+
+// harborRegistrySchema returns the attributes used to connect to the Harbor API of the registry,
+// they are shared by the resources managing the objects inside the registry
+func harborRegistrySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"registry_url": {
+			Type:        schema.TypeString,
+			Description: "URL of the registry, the url attribute of ovh_cloud_project_containerregistry",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"registry_user": {
+			Type:        schema.TypeString,
+			Description: "User of the registry, e.g. created with ovh_cloud_project_containerregistry_user",
+			Required:    true,
+		},
+		"registry_password": {
+			Type:        schema.TypeString,
+			Description: "Password of the registry user",
+			Required:    true,
+			Sensitive:   true,
+		},
+	}
+}
+
+// harborResourceSchema adds the registry connection attributes to the schema of a resource
+func harborResourceSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	for k, v := range harborRegistrySchema() {
+		s[k] = v
+	}
+	return s
+}
+
+func harborClientFromResource(ctx context.Context, d *schema.ResourceData) *harborClient {
+	return newHarborClient(
+		ctx,
+		d.Get("registry_url").(string),
+		d.Get("registry_user").(string),
+		d.Get("registry_password").(string),
+	)
+}
+
+// harborImportRegistry sets the registry connection attributes of an imported resource,
+// they can't be part of the import id
+func harborImportRegistry(d *schema.ResourceData) error {
+	for attr, env := range map[string]string{
+		"registry_url":      "OVH_CLOUD_PROJECT_CONTAINERREGISTRY_URL",
+		"registry_user":     "OVH_CLOUD_PROJECT_CONTAINERREGISTRY_USER",
+		"registry_password": "OVH_CLOUD_PROJECT_CONTAINERREGISTRY_PASSWORD",
+	} {
+		v := os.Getenv(env)
+		if v == "" {
+			return fmt.Errorf("%s must be set to import a registry object", env)
+		}
+		d.Set(attr, v)
+	}
+
+	log.Printf("[DEBUG] Will import from registry %s", d.Get("registry_url"))
+	return nil
+}
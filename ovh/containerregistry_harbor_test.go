@@ -0,0 +1,298 @@
+package ovh
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	testHarborUser     = "admin"
+	testHarborPassword = "secret"
+)
+
+// testHarborServer is a local stand-in of the Harbor API of a registry,
+// it keeps the projects, robot accounts and tag rules in memory
+type testHarborServer struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	lastId     int64
+	projects   map[int64]*HarborProject
+	quotas     map[int64]*HarborQuota
+	robots     map[int64]*HarborRobot
+	retentions map[int64]*HarborRetentionPolicy
+	immutables map[int64][]HarborTagRule
+}
+
+func newTestHarborServer(t *testing.T) *testHarborServer {
+	s := &testHarborServer{
+		projects:   make(map[int64]*HarborProject),
+		quotas:     make(map[int64]*HarborQuota),
+		robots:     make(map[int64]*HarborRobot),
+		retentions: make(map[int64]*HarborRetentionPolicy),
+		immutables: make(map[int64][]HarborTagRule),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *testHarborServer) nextId() int64 {
+	s.lastId++
+	return s.lastId
+}
+
+func (s *testHarborServer) serve(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if user, password, ok := r.BasicAuth(); !ok || user != testHarborUser || password != testHarborPassword {
+		testHarborError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/api/v2.0")
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	switch {
+	case parts[0] == "projects" && len(parts) == 1 && r.Method == http.MethodPost:
+		opts := &HarborProjectCreateOpts{}
+		json.NewDecoder(r.Body).Decode(opts)
+		for _, p := range s.projects {
+			if p.Name == opts.ProjectName {
+				testHarborError(w, http.StatusConflict, "project already exists")
+				return
+			}
+		}
+		id := s.nextId()
+		s.projects[id] = &HarborProject{ProjectId: id, Name: opts.ProjectName, Metadata: opts.Metadata}
+		s.quotas[id] = &HarborQuota{Id: s.nextId(), Hard: map[string]int64{"storage": opts.StorageLimit}, Used: map[string]int64{"storage": 0}}
+		w.Header().Set("Location", fmt.Sprintf("/api/v2.0/projects/%d", id))
+		w.WriteHeader(http.StatusCreated)
+
+	case parts[0] == "projects" && len(parts) == 2:
+		project := s.project(parts[1])
+		if project == nil {
+			testHarborError(w, http.StatusNotFound, "project not found")
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			json.NewEncoder(w).Encode(project)
+		case http.MethodPut:
+			opts := &HarborProjectUpdateOpts{}
+			json.NewDecoder(r.Body).Decode(opts)
+			project.Metadata.Public = opts.Metadata.Public
+		case http.MethodDelete:
+			delete(s.projects, project.ProjectId)
+		}
+
+	case parts[0] == "quotas" && len(parts) == 1:
+		id, _ := strconv.ParseInt(r.URL.Query().Get("reference_id"), 10, 64)
+		res := []*HarborQuota{}
+		if quota, ok := s.quotas[id]; ok {
+			res = append(res, quota)
+		}
+		json.NewEncoder(w).Encode(res)
+
+	case parts[0] == "quotas" && len(parts) == 2 && r.Method == http.MethodPut:
+		id, _ := strconv.ParseInt(parts[1], 10, 64)
+		opts := &HarborQuotaUpdateOpts{}
+		json.NewDecoder(r.Body).Decode(opts)
+		for _, quota := range s.quotas {
+			if quota.Id == id {
+				quota.Hard = opts.Hard
+				return
+			}
+		}
+		testHarborError(w, http.StatusNotFound, "quota not found")
+
+	case parts[0] == "robots" && len(parts) == 1 && r.Method == http.MethodPost:
+		robot := &HarborRobot{}
+		json.NewDecoder(r.Body).Decode(robot)
+		robot.Id = s.nextId()
+		if robot.Level == "project" {
+			robot.Name = "robot$" + robot.Permissions[0].Namespace + "+" + robot.Name
+		} else {
+			robot.Name = "robot$" + robot.Name
+		}
+		robot.ExpiresAt = -1
+		s.robots[robot.Id] = robot
+		w.Header().Set("Location", fmt.Sprintf("/api/v2.0/robots/%d", robot.Id))
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(HarborRobotCreateResponse{Id: robot.Id, Name: robot.Name, Secret: "robot-secret"})
+
+	case parts[0] == "robots" && len(parts) == 2:
+		id, _ := strconv.ParseInt(parts[1], 10, 64)
+		robot, ok := s.robots[id]
+		if !ok {
+			testHarborError(w, http.StatusNotFound, "robot not found")
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			json.NewEncoder(w).Encode(robot)
+		case http.MethodPut:
+			update := &HarborRobot{}
+			json.NewDecoder(r.Body).Decode(update)
+			if update.Name != robot.Name {
+				testHarborError(w, http.StatusBadRequest, "cannot update the name of a robot")
+				return
+			}
+			s.robots[id] = update
+		case http.MethodDelete:
+			delete(s.robots, id)
+		}
+
+	case parts[0] == "retentions" && len(parts) == 1 && r.Method == http.MethodPost:
+		policy := &HarborRetentionPolicy{}
+		json.NewDecoder(r.Body).Decode(policy)
+		project, ok := s.projects[policy.Scope.Ref]
+		if !ok {
+			testHarborError(w, http.StatusBadRequest, "project not found")
+			return
+		}
+		policy.Id = s.nextId()
+		s.retentions[policy.Id] = policy
+		retentionId := strconv.FormatInt(policy.Id, 10)
+		project.Metadata.RetentionId = &retentionId
+		w.Header().Set("Location", fmt.Sprintf("/api/v2.0/retentions/%d", policy.Id))
+		w.WriteHeader(http.StatusCreated)
+
+	case parts[0] == "retentions" && len(parts) == 2:
+		id, _ := strconv.ParseInt(parts[1], 10, 64)
+		if _, ok := s.retentions[id]; !ok {
+			testHarborError(w, http.StatusNotFound, "retention not found")
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			json.NewEncoder(w).Encode(s.retentions[id])
+		case http.MethodPut:
+			policy := &HarborRetentionPolicy{}
+			json.NewDecoder(r.Body).Decode(policy)
+			s.retentions[id] = policy
+		case http.MethodDelete:
+			if project, ok := s.projects[s.retentions[id].Scope.Ref]; ok {
+				project.Metadata.RetentionId = nil
+			}
+			delete(s.retentions, id)
+		}
+
+	case parts[0] == "projects" && len(parts) >= 3 && parts[2] == "immutabletagrules":
+		project := s.project(parts[1])
+		if project == nil {
+			testHarborError(w, http.StatusNotFound, "project not found")
+			return
+		}
+		rules := s.immutables[project.ProjectId]
+		switch {
+		case len(parts) == 3 && r.Method == http.MethodGet:
+			if rules == nil {
+				rules = []HarborTagRule{}
+			}
+			json.NewEncoder(w).Encode(rules)
+		case len(parts) == 3 && r.Method == http.MethodPost:
+			rule := HarborTagRule{}
+			json.NewDecoder(r.Body).Decode(&rule)
+			rule.Id = s.nextId()
+			s.immutables[project.ProjectId] = append(rules, rule)
+			w.Header().Set("Location", fmt.Sprintf("/api/v2.0/projects/%d/immutabletagrules/%d", project.ProjectId, rule.Id))
+			w.WriteHeader(http.StatusCreated)
+		case len(parts) == 4:
+			id, _ := strconv.ParseInt(parts[3], 10, 64)
+			for i, rule := range rules {
+				if rule.Id != id {
+					continue
+				}
+				if r.Method == http.MethodDelete {
+					s.immutables[project.ProjectId] = append(rules[:i], rules[i+1:]...)
+				} else {
+					update := HarborTagRule{}
+					json.NewDecoder(r.Body).Decode(&update)
+					rules[i] = update
+				}
+				return
+			}
+			testHarborError(w, http.StatusNotFound, "rule not found")
+		}
+
+	default:
+		testHarborError(w, http.StatusNotFound, "unknown endpoint "+r.Method+" "+path)
+	}
+}
+
+// project returns the project with the given id or name
+func (s *testHarborServer) project(nameOrId string) *HarborProject {
+	if id, err := strconv.ParseInt(nameOrId, 10, 64); err == nil {
+		return s.projects[id]
+	}
+	for _, p := range s.projects {
+		if p.Name == nameOrId {
+			return p
+		}
+	}
+	return nil
+}
+
+func testHarborError(w http.ResponseWriter, code int, message string) {
+	w.WriteHeader(code)
+	fmt.Fprintf(w, `{"errors":[{"code":"%s","message":"%s"}]}`, http.StatusText(code), message)
+}
+
+// testHarborResourceData returns the data of a resource connected to the stand-in registry
+func testHarborResourceData(t *testing.T, s *testHarborServer, r func() *schema.Resource, raw map[string]interface{}) *schema.ResourceData {
+	raw["registry_url"] = s.URL
+	raw["registry_user"] = testHarborUser
+	raw["registry_password"] = testHarborPassword
+	return schema.TestResourceDataRaw(t, r().Schema, raw)
+}
+
+func Test_newHarborClient(t *testing.T) {
+	tests := map[string]string{
+		"xxx.gra7.container-registry.ovh.net":          "https://xxx.gra7.container-registry.ovh.net/api/v2.0",
+		"https://xxx.gra7.container-registry.ovh.net/": "https://xxx.gra7.container-registry.ovh.net/api/v2.0",
+		"http://127.0.0.1:8080":                        "http://127.0.0.1:8080/api/v2.0",
+	}
+	for registryUrl, want := range tests {
+		if got := newHarborClient(context.Background(), registryUrl, "", "").endpoint; got != want {
+			t.Errorf("newHarborClient(%q).endpoint = %q, want %q", registryUrl, got, want)
+		}
+	}
+}
+
+func TestHarborClient_errors(t *testing.T) {
+	s := newTestHarborServer(t)
+
+	err := newHarborClient(context.Background(), s.URL, testHarborUser, "wrong").Get("/projects/1", &HarborProject{})
+	if errHarbor, ok := err.(*HarborAPIError); !ok || errHarbor.Code != http.StatusUnauthorized || errHarbor.Message != "unauthorized" {
+		t.Errorf("expected an unauthorized HarborAPIError, got %v", err)
+	}
+
+	err = newHarborClient(context.Background(), s.URL, testHarborUser, testHarborPassword).Get("/projects/1", &HarborProject{})
+	if !isHarborNotFound(err) {
+		t.Errorf("expected a not found HarborAPIError, got %v", err)
+	}
+}
+
+func Test_harborSecretRegexp(t *testing.T) {
+	tests := map[string]string{
+		`{"id":1,"name":"robot$ci","secret":"s3cr\"et"}`: `{"id":1,"name":"robot$ci",***}`,
+		`{"secret": "s3cret", "expires_at": -1}`:         `{***, "expires_at": -1}`,
+		`{"id":1,"name":"robot$ci"}`:                     `{"id":1,"name":"robot$ci"}`,
+	}
+	for body, want := range tests {
+		if got := harborSecretRegexp.ReplaceAllString(body, "***"); got != want {
+			t.Errorf("masked %s = %s, want %s", body, got, want)
+		}
+	}
+}
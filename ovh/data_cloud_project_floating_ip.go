@@ -0,0 +1,73 @@
+package ovh
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func dataSourceCloudProjectFloatingIp() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudProjectFloatingIpRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "Service name",
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+			},
+			"region_name": {
+				Type:        schema.TypeString,
+				Description: "Region of the floating IP",
+				Required:    true,
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Description: "ID of the floating IP",
+				Required:    true,
+			},
+
+			// Computed
+			"ip": {
+				Type:        schema.TypeString,
+				Description: "IP address of the floating IP",
+				Computed:    true,
+			},
+			"network_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the public network of the floating IP",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Status of the floating IP",
+				Computed:    true,
+			},
+			"associated_entity": cloudProjectFloatingIpAssociatedEntitySchema(),
+		},
+	}
+}
+
+func dataSourceCloudProjectFloatingIpRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+	id := d.Get("id").(string)
+
+	endpoint := cloudProjectFloatingIpEndpoint(serviceName, regionName, id)
+	res := &CloudProjectFloatingIpResponse{}
+
+	log.Printf("[DEBUG] Will read floating IP %s", id)
+	if err := config.OVHClient.Get(endpoint, res); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.SetId(res.Id)
+	for k, v := range res.ToMap() {
+		d.Set(k, v)
+	}
+
+	log.Printf("[DEBUG] Read floating IP: %+v", res)
+	return nil
+}
@@ -0,0 +1,96 @@
+package ovh
+
+import (
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers/hashcode"
+)
+
+func dataSourceCloudProjectFloatingIps() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudProjectFloatingIpsRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "Service name",
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+			},
+			"region_name": {
+				Type:        schema.TypeString,
+				Description: "Region of the floating IPs",
+				Required:    true,
+			},
+
+			// Computed
+			"floating_ips": {
+				Type:        schema.TypeList,
+				Description: "Floating IPs of the region",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "ID of the floating IP",
+							Computed:    true,
+						},
+						"ip": {
+							Type:        schema.TypeString,
+							Description: "IP address of the floating IP",
+							Computed:    true,
+						},
+						"network_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the public network of the floating IP",
+							Computed:    true,
+						},
+						"region_name": {
+							Type:        schema.TypeString,
+							Description: "Region of the floating IP",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "Status of the floating IP",
+							Computed:    true,
+						},
+						"associated_entity": cloudProjectFloatingIpAssociatedEntitySchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudProjectFloatingIpsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	endpoint := cloudProjectFloatingIpEndpoint(serviceName, regionName)
+	var res []CloudProjectFloatingIpResponse
+
+	log.Printf("[DEBUG] Will read floating IPs of region %s in project %s", regionName, serviceName)
+	if err := config.OVHClient.Get(endpoint, &res); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Id < res[j].Id })
+
+	floatingIps := make([]map[string]interface{}, len(res))
+	ids := make([]string, len(res))
+	for i, floatingIp := range res {
+		floatingIps[i] = floatingIp.ToMap()
+		floatingIps[i]["id"] = floatingIp.Id
+		ids[i] = floatingIp.Id
+	}
+
+	d.SetId(hashcode.Strings(append([]string{serviceName, regionName}, ids...)))
+	d.Set("floating_ips", floatingIps)
+
+	log.Printf("[DEBUG] Read floating IPs: %+v", res)
+	return nil
+}
@@ -0,0 +1,97 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudProjectKubeFlavors() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudProjectKubeFlavorsRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "Service name",
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Description: "Region of the flavors",
+				Optional:    true,
+			},
+
+			// Computed
+			"flavors": {
+				Type:        schema.TypeList,
+				Description: "Flavors available for the nodes of a managed Kubernetes cluster",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Flavor name",
+							Computed:    true,
+						},
+						"category": {
+							Type:        schema.TypeString,
+							Description: "Flavor category",
+							Computed:    true,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Description: "Flavor state",
+							Computed:    true,
+						},
+						"vcpus": {
+							Type:        schema.TypeInt,
+							Description: "Number of virtual CPUs",
+							Computed:    true,
+						},
+						"ram": {
+							Type:        schema.TypeInt,
+							Description: "Amount of RAM in GB",
+							Computed:    true,
+						},
+						"gpus": {
+							Type:        schema.TypeInt,
+							Description: "Number of GPUs",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudProjectKubeFlavorsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	region := d.Get("region").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/capabilities/kube/flavors", url.PathEscape(serviceName))
+	if region != "" {
+		endpoint += "?region=" + url.QueryEscape(region)
+	}
+	res := make([]CloudProjectKubeFlavor, 0)
+
+	log.Printf("[DEBUG] Will read kube flavors for project %s", serviceName)
+	if err := config.OVHClient.Get(endpoint, &res); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+
+	flavors := make([]map[string]interface{}, len(res))
+	for i, flavor := range res {
+		flavors[i] = flavor.ToMap()
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serviceName, region))
+	d.Set("flavors", flavors)
+
+	log.Printf("[DEBUG] Read kube flavors: %+v", res)
+	return nil
+}
@@ -0,0 +1,36 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudProjectKubeFlavorsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloud(t)
+			testAccPreCheckKubernetes(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudProjectKubeFlavorsDatasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_project_kube_flavors.flavors", "flavors.#"),
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_project_kube_flavors.flavors", "flavors.0.name"),
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_project_kube_flavors.flavors", "flavors.0.category"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCloudProjectKubeFlavorsDatasourceConfig = fmt.Sprintf(`
+data "ovh_cloud_project_kube_flavors" "flavors" {
+  service_name = "%s"
+  region       = "%s"
+}
+`, os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST"), os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST"))
@@ -0,0 +1,181 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func dataSourceCloudProjectKubeHealth() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudProjectKubeHealthRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "Service name",
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+			},
+			"kube_id": {
+				Type:        schema.TypeString,
+				Description: "Kube ID",
+				Required:    true,
+			},
+
+			// Computed
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Cluster status",
+				Computed:    true,
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Description: "Kubernetes version of the cluster",
+				Computed:    true,
+			},
+			"is_up_to_date": {
+				Type:        schema.TypeBool,
+				Description: "True if all nodes and control-plane are up-to-date",
+				Computed:    true,
+			},
+			"control_plane_is_up_to_date": {
+				Type:        schema.TypeBool,
+				Description: "True if control-plane is up-to-date",
+				Computed:    true,
+			},
+			"etcd_usage": {
+				Type:        schema.TypeInt,
+				Description: "Space used by etcd, in bytes",
+				Computed:    true,
+			},
+			"etcd_quota": {
+				Type:        schema.TypeInt,
+				Description: "Maximum space etcd can use, in bytes",
+				Computed:    true,
+			},
+			"etcd_usage_percent": {
+				Type:        schema.TypeFloat,
+				Description: "Space used by etcd, in percent of its quota",
+				Computed:    true,
+			},
+			"nodepools_ready": {
+				Type:        schema.TypeBool,
+				Description: "True if all the nodepools are ready",
+				Computed:    true,
+			},
+			"nodepools": {
+				Type:        schema.TypeList,
+				Description: "Readiness of the nodepools of the cluster",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "NodePool ID",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "NodePool name",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "Current status",
+							Computed:    true,
+						},
+						"desired_nodes": {
+							Type:        schema.TypeInt,
+							Description: "Number of nodes desired in the pool",
+							Computed:    true,
+						},
+						"available_nodes": {
+							Type:        schema.TypeInt,
+							Description: "Number of nodes which are actually ready in the pool",
+							Computed:    true,
+						},
+						"up_to_date_nodes": {
+							Type:        schema.TypeInt,
+							Description: "Number of nodes with the latest version installed in the pool",
+							Computed:    true,
+						},
+						"ready": {
+							Type:        schema.TypeBool,
+							Description: "True if the pool is READY with all its desired nodes available",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudProjectKubeHealthRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	kubeId := d.Get("kube_id").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s", url.PathEscape(serviceName), url.PathEscape(kubeId))
+	kube := &CloudProjectKubeResponse{}
+
+	log.Printf("[DEBUG] Will read kube %s from project: %s", kubeId, serviceName)
+	if err := config.OVHClient.Get(endpoint, kube); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	endpoint = fmt.Sprintf("/cloud/project/%s/kube/%s/metrics/etcdUsage", url.PathEscape(serviceName), url.PathEscape(kubeId))
+	etcdUsage := &CloudProjectKubeEtcdUsageResponse{}
+
+	log.Printf("[DEBUG] Will read etcd usage of kube %s from project: %s", kubeId, serviceName)
+	if err := config.OVHClient.Get(endpoint, etcdUsage); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+
+	endpoint = fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool", url.PathEscape(serviceName), url.PathEscape(kubeId))
+	var nodePools []CloudProjectKubeNodePoolResponse
+
+	log.Printf("[DEBUG] Will read nodepools of kube %s from project: %s", kubeId, serviceName)
+	if err := config.OVHClient.Get(endpoint, &nodePools); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+
+	nodePoolsReady := true
+	nodePoolsHealth := make([]map[string]interface{}, len(nodePools))
+	for i, nodePool := range nodePools {
+		ready := cloudProjectKubeNodePoolIsReady(nodePool)
+		nodePoolsReady = nodePoolsReady && ready
+
+		nodePoolsHealth[i] = map[string]interface{}{
+			"id":               nodePool.Id,
+			"name":             nodePool.Name,
+			"status":           nodePool.Status,
+			"desired_nodes":    nodePool.DesiredNodes,
+			"available_nodes":  nodePool.AvailableNodes,
+			"up_to_date_nodes": nodePool.UpToDateNodes,
+			"ready":            ready,
+		}
+	}
+
+	d.SetId(kubeId)
+	d.Set("status", kube.Status)
+	d.Set("version", kube.Version)
+	d.Set("is_up_to_date", kube.IsUpToDate)
+	d.Set("control_plane_is_up_to_date", kube.ControlPlaneIsUpToDate)
+	d.Set("etcd_usage", etcdUsage.Usage)
+	d.Set("etcd_quota", etcdUsage.Quota)
+	d.Set("etcd_usage_percent", etcdUsage.UsagePercent())
+	d.Set("nodepools_ready", nodePoolsReady)
+	d.Set("nodepools", nodePoolsHealth)
+
+	log.Printf("[DEBUG] Read health of kube %s: etcd %+v, nodepools %+v", kubeId, etcdUsage, nodePoolsHealth)
+	return nil
+}
+
+// cloudProjectKubeNodePoolIsReady returns true if the pool is READY with all its desired nodes available
+func cloudProjectKubeNodePoolIsReady(nodePool CloudProjectKubeNodePoolResponse) bool {
+	return nodePool.Status == "READY" && nodePool.AvailableNodes >= nodePool.DesiredNodes
+}
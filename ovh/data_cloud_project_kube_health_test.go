@@ -0,0 +1,94 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestCloudProjectKubeEtcdUsageResponse_UsagePercent(t *testing.T) {
+	if got := (CloudProjectKubeEtcdUsageResponse{Quota: 200, Usage: 50}).UsagePercent(); got != 25 {
+		t.Errorf("UsagePercent() = %v, want 25", got)
+	}
+	if got := (CloudProjectKubeEtcdUsageResponse{}).UsagePercent(); got != 0 {
+		t.Errorf("UsagePercent() = %v, want 0", got)
+	}
+}
+
+func Test_cloudProjectKubeNodePoolIsReady(t *testing.T) {
+	tests := []struct {
+		name     string
+		nodePool CloudProjectKubeNodePoolResponse
+		want     bool
+	}{
+		{name: "ready", nodePool: CloudProjectKubeNodePoolResponse{Status: "READY", DesiredNodes: 2, AvailableNodes: 2}, want: true},
+		{name: "missing nodes", nodePool: CloudProjectKubeNodePoolResponse{Status: "READY", DesiredNodes: 2, AvailableNodes: 1}, want: false},
+		{name: "resizing", nodePool: CloudProjectKubeNodePoolResponse{Status: "RESIZING", DesiredNodes: 2, AvailableNodes: 2}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cloudProjectKubeNodePoolIsReady(tt.nodePool); got != tt.want {
+				t.Errorf("cloudProjectKubeNodePoolIsReady() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccCloudProjectKubeHealthDataSource_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	config := fmt.Sprintf(
+		testAccCloudProjectKubeHealthDataSourceConfig,
+		os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST"),
+		name,
+		os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckKubernetes(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_cloud_project_kube_health.health", "status", "READY"),
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_project_kube_health.health", "etcd_quota"),
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_project_kube_health.health", "etcd_usage_percent"),
+					resource.TestCheckResourceAttr("data.ovh_cloud_project_kube_health.health", "nodepools.#", "1"),
+					resource.TestCheckResourceAttr("data.ovh_cloud_project_kube_health.health", "nodepools.0.ready", "true"),
+					resource.TestCheckResourceAttr("data.ovh_cloud_project_kube_health.health", "nodepools_ready", "true"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCloudProjectKubeHealthDataSourceConfig = `
+resource "ovh_cloud_project_kube" "cluster" {
+	service_name  = "%s"
+	name          = "%s"
+	region        = "%s"
+}
+
+resource "ovh_cloud_project_kube_nodepool" "pool" {
+	service_name  = ovh_cloud_project_kube.cluster.service_name
+	kube_id       = ovh_cloud_project_kube.cluster.id
+	name          = ovh_cloud_project_kube.cluster.name
+	flavor_name   = "b2-7"
+	desired_nodes = 1
+}
+
+data "ovh_cloud_project_kube_health" "health" {
+	service_name = ovh_cloud_project_kube.cluster.service_name
+	kube_id      = ovh_cloud_project_kube.cluster.id
+
+	depends_on = [
+		ovh_cloud_project_kube_nodepool.pool
+	]
+}
+`
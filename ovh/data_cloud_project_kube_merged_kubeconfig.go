@@ -0,0 +1,117 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers/hashcode"
+)
+
+func dataSourceCloudProjectKubeMergedKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudProjectKubeMergedKubeconfigRead,
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeList,
+				Description: "Clusters to merge in the kubeconfig file",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_name": {
+							Type:        schema.TypeString,
+							Description: "Service name",
+							Required:    true,
+						},
+						"kube_id": {
+							Type:        schema.TypeString,
+							Description: "Kube ID",
+							Required:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the cluster, user and context of this cluster in the kubeconfig file",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"current_context": {
+				Type:        schema.TypeString,
+				Description: "Context to use by default, defaults to the context of the first cluster",
+				Optional:    true,
+				Computed:    true,
+			},
+
+			// Computed
+			"contexts": {
+				Type:        schema.TypeList,
+				Description: "Names of the contexts of the kubeconfig file",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"kubeconfig": {
+				Type:        schema.TypeString,
+				Description: "Merged kubeconfig file",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func dataSourceCloudProjectKubeMergedKubeconfigRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	clusters := d.Get("cluster").([]interface{})
+	kubeconfigs := make([]*KubectlConfig, len(clusters))
+	names := make([]string, len(clusters))
+	ids := make([]string, len(clusters))
+
+	for i, c := range clusters {
+		cluster := c.(map[string]interface{})
+		serviceName := cluster["service_name"].(string)
+		kubeId := cluster["kube_id"].(string)
+
+		log.Printf("[DEBUG] Will read kubeconfig of kube %s from project %s", kubeId, serviceName)
+		kubeconfig, err := getKubeconfig(config, serviceName, kubeId)
+		if err != nil {
+			return fmt.Errorf("reading kubeconfig of kube %s from project %s: %w", kubeId, serviceName, err)
+		}
+
+		kubeconfigs[i] = kubeconfig
+		names[i] = cluster["name"].(string)
+		ids[i] = serviceName + "/" + kubeId
+	}
+
+	merged := mergeKubeconfigs(kubeconfigs, names)
+
+	contexts := make([]string, len(merged.Contexts))
+	for i, context := range merged.Contexts {
+		contexts[i] = context.Name
+	}
+
+	if currentContext, ok := d.GetOk("current_context"); ok {
+		found := false
+		for _, context := range contexts {
+			found = found || context == currentContext.(string)
+		}
+		if !found {
+			return fmt.Errorf("current_context %q is not one of the merged contexts %v", currentContext, contexts)
+		}
+		merged.CurrentContext = currentContext.(string)
+	}
+
+	kubeconfig, err := renderKubeconfig(merged)
+	if err != nil {
+		return fmt.Errorf("rendering merged kubeconfig: %w", err)
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	d.Set("current_context", merged.CurrentContext)
+	d.Set("contexts", contexts)
+	d.Set("kubeconfig", kubeconfig)
+
+	return nil
+}
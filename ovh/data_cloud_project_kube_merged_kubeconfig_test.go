@@ -0,0 +1,64 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testAccCloudProjectKubeMergedKubeconfigConfig = `
+resource "ovh_cloud_project_kube" "cluster" {
+	service_name  = "%s"
+	name          = "%s"
+	region        = "%s"
+}
+
+data "ovh_cloud_project_kube_merged_kubeconfig" "kubeconfig" {
+	cluster {
+		service_name = ovh_cloud_project_kube.cluster.service_name
+		kube_id      = ovh_cloud_project_kube.cluster.id
+		name         = "first"
+	}
+
+	cluster {
+		service_name = ovh_cloud_project_kube.cluster.service_name
+		kube_id      = ovh_cloud_project_kube.cluster.id
+		name         = "second"
+	}
+
+	current_context = "second"
+}
+`
+
+func TestAccCloudProjectKubeMergedKubeconfigDataSource_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	config := fmt.Sprintf(
+		testAccCloudProjectKubeMergedKubeconfigConfig,
+		os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST"),
+		name,
+		os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloud(t)
+			testAccCheckCloudProjectExists(t)
+			testAccPreCheckKubernetes(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_cloud_project_kube_merged_kubeconfig.kubeconfig", "contexts.#", "2"),
+					resource.TestCheckResourceAttr("data.ovh_cloud_project_kube_merged_kubeconfig.kubeconfig", "contexts.0", "first"),
+					resource.TestCheckResourceAttr("data.ovh_cloud_project_kube_merged_kubeconfig.kubeconfig", "current_context", "second"),
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_project_kube_merged_kubeconfig.kubeconfig", "kubeconfig"),
+				),
+			},
+		},
+	})
+}
@@ -80,6 +80,32 @@ func dataSourceCloudProjectKubeNodepool() *schema.Resource {
 				Description: "Number of nodes present in the pool",
 				Computed:    true,
 			},
+			"availability_zones": {
+				Type:        schema.TypeList,
+				Description: "Availability zones in which the nodes of the pool are spread",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"attach_floating_ips": {
+				Type:        schema.TypeList,
+				Description: "Floating IPs attached to the nodes of the pool",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Description: "True if a floating IP is attached to each node of the pool",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"nodes_per_availability_zone": {
+				Type:        schema.TypeMap,
+				Description: "Number of nodes of the pool in each of its availability zones",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
 			"flavor": {
 				Type:        schema.TypeString,
 				Description: "Flavor name",
@@ -242,6 +268,12 @@ func dataSourceCloudProjectKubeNodePoolRead(d *schema.ResourceData, meta interfa
 		}
 	}
 
+	nodesPerZone, err := getCloudProjectKubeNodePoolNodesPerAvailabilityZone(config, serviceName, kubeId, nodepoolTarget)
+	if err != nil {
+		return err
+	}
+	d.Set("nodes_per_availability_zone", nodesPerZone)
+
 	log.Printf("[DEBUG] Read nodepool: %+v", res)
 	return nil
 }
@@ -99,6 +99,16 @@ func dataSourceCloudProjectKubeNodepoolNodes() *schema.Resource {
 							Description: "Node version",
 							Computed:    true,
 						},
+						"public_ipv4": {
+							Type:        schema.TypeString,
+							Description: "Public IPv4 address of the underlying instance of the node",
+							Computed:    true,
+						},
+						"floating_ip": {
+							Type:        schema.TypeString,
+							Description: "Floating IP attached to the node, when the node pool attaches floating IPs",
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -143,12 +153,29 @@ func dataSourceCloudProjectKubeNodePoolNodesRead(d *schema.ResourceData, meta in
 		return helpers.CheckDeleted(d, err, endpointNodepoolNodes)
 	}
 
+	networkId, floatingIps := "", map[string]string{}
+	if nodepoolTarget.AttachFloatingIps != nil && nodepoolTarget.AttachFloatingIps.Enabled {
+		var err error
+		if networkId, floatingIps, err = getCloudProjectKubeFloatingIps(config, serviceName, kubeId); err != nil {
+			return err
+		}
+	}
+
 	nodes := make([]map[string]interface{}, len(resNodepoolNodes))
 	ids := make([]string, len(resNodepoolNodes))
 
 	for i, node := range resNodepoolNodes {
 		nodes[i] = node.ToMap()
 		ids = append(ids, node.Id)
+
+		instance, err := getCloudProjectKubeNodeInstance(config, serviceName, node.InstanceId)
+		if err != nil {
+			return err
+		}
+		if instance != nil {
+			nodes[i]["public_ipv4"] = instance.PublicIpv4()
+			nodes[i]["floating_ip"] = kubeNodeFloatingIp(instance, networkId, floatingIps)
+		}
 	}
 
 	// sort.Strings sorts in place, returns nothing
@@ -160,3 +187,64 @@ func dataSourceCloudProjectKubeNodePoolNodesRead(d *schema.ResourceData, meta in
 	log.Printf("[DEBUG] Read nodepool nodes: %+v", resNodepoolNodes)
 	return nil
 }
+
+// getCloudProjectKubeFloatingIps returns the private network of the cluster and the floating IPs routed
+// by its gateways, indexed by the private IP they are associated with
+func getCloudProjectKubeFloatingIps(config *Config, serviceName, kubeId string) (string, map[string]string, error) {
+	kube := &CloudProjectKubeResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s", url.PathEscape(serviceName), url.PathEscape(kubeId))
+	if err := config.OVHClient.Get(endpoint, kube); err != nil {
+		return "", nil, fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+
+	var gateways []CloudProjectGatewayResponse
+	endpoint = fmt.Sprintf("/cloud/project/%s/region/%s/gateway", url.PathEscape(serviceName), url.PathEscape(kube.Region))
+	log.Printf("[DEBUG] Will read gateways of region %s in project %s", kube.Region, serviceName)
+	if err := config.OVHClient.Get(endpoint, &gateways); err != nil {
+		return "", nil, fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+
+	var floatingIps []CloudProjectFloatingIpResponse
+	endpoint = fmt.Sprintf("/cloud/project/%s/region/%s/floatingip", url.PathEscape(serviceName), url.PathEscape(kube.Region))
+	log.Printf("[DEBUG] Will read floating IPs of region %s in project %s", kube.Region, serviceName)
+	if err := config.OVHClient.Get(endpoint, &floatingIps); err != nil {
+		return "", nil, fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+
+	return kube.PrivateNetworkId, kubeNetworkFloatingIps(kube.PrivateNetworkId, gateways, floatingIps), nil
+}
+
+// kubeNetworkFloatingIps indexes by private IP the floating IPs routed by a gateway of the network,
+// the private IPs of other networks possibly being the same
+func kubeNetworkFloatingIps(networkId string, gateways []CloudProjectGatewayResponse, floatingIps []CloudProjectFloatingIpResponse) map[string]string {
+	gatewayIds := map[string]bool{}
+	for _, gateway := range gateways {
+		for _, i := range gateway.Interfaces {
+			if i != nil && i.NetworkId == networkId {
+				gatewayIds[gateway.Id] = true
+			}
+		}
+	}
+
+	res := make(map[string]string, len(floatingIps))
+	for _, floatingIp := range floatingIps {
+		entity := floatingIp.AssociatedEntity
+		if entity != nil && entity.Ip != "" && gatewayIds[entity.GatewayId] {
+			res[entity.Ip] = floatingIp.Ip
+		}
+	}
+	return res
+}
+
+// kubeNodeFloatingIp returns the floating IP associated with one of the IPs of the instance in the private network
+func kubeNodeFloatingIp(instance *CloudProjectInstanceResponse, networkId string, floatingIps map[string]string) string {
+	for _, ip := range instance.IpAddresses {
+		if ip.Type != "private" || ip.NetworkId != networkId {
+			continue
+		}
+		if floatingIp, ok := floatingIps[ip.Ip]; ok {
+			return floatingIp
+		}
+	}
+	return ""
+}
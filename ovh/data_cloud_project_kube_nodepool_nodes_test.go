@@ -3,6 +3,8 @@ package ovh
 import (
 	"fmt"
 	"os"
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -90,3 +92,160 @@ data "ovh_cloud_project_kube_nodepool_nodes" "nodePoolNodesDataSource" {
   ]
 }
 `
+
+func Test_kubeNodeFloatingIp(t *testing.T) {
+	instance := &CloudProjectInstanceResponse{
+		IpAddresses: []CloudProjectInstanceIpAddress{
+			{Ip: "51.68.0.10", Type: "public", Version: 4, NetworkId: "ext-net"},
+			{Ip: "10.1.0.12", Type: "private", Version: 4, NetworkId: "other-net"},
+			{Ip: "10.0.0.12", Type: "private", Version: 4, NetworkId: "kube-net"},
+		},
+	}
+
+	if got := kubeNodeFloatingIp(instance, "kube-net", map[string]string{"10.0.0.12": "57.128.0.1"}); got != "57.128.0.1" {
+		t.Errorf("kubeNodeFloatingIp() = %s, want 57.128.0.1", got)
+	}
+	if got := kubeNodeFloatingIp(instance, "kube-net", map[string]string{"51.68.0.10": "57.128.0.1"}); got != "" {
+		t.Errorf("kubeNodeFloatingIp() = %s, want no floating IP for a public IP", got)
+	}
+	if got := kubeNodeFloatingIp(instance, "kube-net", map[string]string{"10.1.0.12": "57.128.0.1"}); got != "" {
+		t.Errorf("kubeNodeFloatingIp() = %s, want no floating IP for an IP of another network", got)
+	}
+}
+
+func Test_kubeNetworkFloatingIps(t *testing.T) {
+	gateways := []CloudProjectGatewayResponse{
+		{Id: "kube-gw", Interfaces: []*CloudProjectGatewayInterface{{NetworkId: "kube-net"}}},
+		{Id: "other-gw", Interfaces: []*CloudProjectGatewayInterface{{NetworkId: "other-net"}}},
+	}
+	floatingIps := []CloudProjectFloatingIpResponse{
+		{Ip: "57.128.0.1", AssociatedEntity: &CloudProjectFloatingIpAssociatedEntity{Ip: "10.0.0.12", GatewayId: "kube-gw"}},
+		{Ip: "57.128.0.2", AssociatedEntity: &CloudProjectFloatingIpAssociatedEntity{Ip: "10.0.0.13", GatewayId: "other-gw"}},
+		{Ip: "57.128.0.3"},
+	}
+
+	want := map[string]string{"10.0.0.12": "57.128.0.1"}
+	if got := kubeNetworkFloatingIps("kube-net", gateways, floatingIps); !reflect.DeepEqual(got, want) {
+		t.Errorf("kubeNetworkFloatingIps() = %v, want %v", got, want)
+	}
+}
+
+var testAccCloudProjectKubeNodePoolNodesFloatingIpsPublicConfig = `
+resource "ovh_cloud_project_kube" "cluster" {
+  service_name = "%s"
+  name         = "%s"
+  region       = "%s"
+}
+
+resource "ovh_cloud_project_kube_nodepool" "pool" {
+  service_name  = ovh_cloud_project_kube.cluster.service_name
+  kube_id       = ovh_cloud_project_kube.cluster.id
+  name          = ovh_cloud_project_kube.cluster.name
+  flavor_name   = "b2-7"
+  desired_nodes = 1
+
+  attach_floating_ips {
+    enabled = true
+  }
+}
+`
+
+var testAccCloudProjectKubeNodePoolNodesFloatingIpsConfig = `
+resource "ovh_vrack_cloudproject" "attach" {
+  service_name = "%s"
+  project_id   = "%s"
+}
+
+resource "ovh_cloud_project_network_private" "network" {
+  service_name = ovh_vrack_cloudproject.attach.project_id
+  vlan_id      = 0
+  name         = "%s"
+  regions      = ["%s"]
+}
+
+resource "ovh_cloud_project_network_private_subnet" "subnet" {
+  service_name = ovh_cloud_project_network_private.network.service_name
+  network_id   = ovh_cloud_project_network_private.network.id
+  region       = "%s"
+  start        = "10.0.0.100"
+  end          = "10.0.0.200"
+  network      = "10.0.0.0/24"
+  dhcp         = true
+  no_gateway   = false
+}
+
+resource "ovh_cloud_project_gateway" "gateway" {
+  service_name = ovh_cloud_project_network_private.network.service_name
+  name         = "%s"
+  model        = "s"
+  region       = ovh_cloud_project_network_private_subnet.subnet.region
+  network_id   = tolist(ovh_cloud_project_network_private.network.regions_attributes[*].openstackid)[0]
+  subnet_id    = ovh_cloud_project_network_private_subnet.subnet.id
+}
+
+resource "ovh_cloud_project_kube" "cluster" {
+  service_name       = ovh_cloud_project_network_private.network.service_name
+  name               = "%s"
+  region             = ovh_cloud_project_network_private_subnet.subnet.region
+  private_network_id = tolist(ovh_cloud_project_network_private.network.regions_attributes[*].openstackid)[0]
+  nodes_subnet_id    = ovh_cloud_project_network_private_subnet.subnet.id
+
+  depends_on = [ovh_cloud_project_gateway.gateway]
+}
+
+resource "ovh_cloud_project_kube_nodepool" "pool" {
+  service_name  = ovh_cloud_project_kube.cluster.service_name
+  kube_id       = ovh_cloud_project_kube.cluster.id
+  name          = ovh_cloud_project_kube.cluster.name
+  flavor_name   = "b2-7"
+  desired_nodes = 1
+
+  attach_floating_ips {
+    enabled = true
+  }
+}
+
+data "ovh_cloud_project_kube_nodepool_nodes" "nodes" {
+  service_name = ovh_cloud_project_kube_nodepool.pool.service_name
+  kube_id      = ovh_cloud_project_kube_nodepool.pool.kube_id
+  name         = ovh_cloud_project_kube_nodepool.pool.name
+}
+`
+
+func TestAccCloudProjectKubeNodePoolNodesDataSource_floatingIps(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	region := os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST")
+	vrackID := os.Getenv("OVH_VRACK_SERVICE_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckKubernetes(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testAccCloudProjectKubeNodePoolNodesFloatingIpsPublicConfig, serviceName, name, region),
+				ExpectError: regexp.MustCompile("floating IPs can only be attached to the nodes of a cluster using a private network"),
+			},
+		},
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckKubernetes(t)
+			testAccPreCheckKubernetesVRack(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudProjectKubeNodePoolNodesFloatingIpsConfig, vrackID, serviceName, name, region, region, name, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube_nodepool.pool", "attach_floating_ips.0.enabled", "true"),
+					resource.TestCheckResourceAttr("data.ovh_cloud_project_kube_nodepool_nodes.nodes", "nodes.#", "1"),
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_project_kube_nodepool_nodes.nodes", "nodes.0.floating_ip"),
+				),
+			},
+		},
+	})
+}
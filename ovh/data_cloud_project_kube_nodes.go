@@ -7,6 +7,7 @@ import (
 	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
 	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
 	"github.com/ovh/terraform-provider-ovh/ovh/helpers/hashcode"
 )
@@ -26,6 +27,26 @@ func dataSourceCloudProjectKubeNodes() *schema.Resource {
 				Description: "Kube ID",
 				Required:    true,
 			},
+			"node_pool_id": {
+				Type:        schema.TypeString,
+				Description: "Only return the nodes of this nodepool",
+				Optional:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Only return the nodes with this status",
+				Optional:    true,
+			},
+			"is_up_to_date": {
+				Type:        schema.TypeBool,
+				Description: "Only return the nodes which are up to date, or not",
+				Optional:    true,
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Description: "Only return the nodes with this version",
+				Optional:    true,
+			},
 
 			// Computed
 			"nodes": {
@@ -94,6 +115,60 @@ func dataSourceCloudProjectKubeNodes() *schema.Resource {
 							Description: "Node version",
 							Computed:    true,
 						},
+						"ip_addresses": {
+							Type:        schema.TypeList,
+							Description: "IP addresses of the Public Cloud instance",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip": {
+										Type:        schema.TypeString,
+										Description: "IP address",
+										Computed:    true,
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Description: "IP address type: public or private",
+										Computed:    true,
+									},
+									"version": {
+										Type:        schema.TypeInt,
+										Description: "IP version",
+										Computed:    true,
+									},
+									"network_id": {
+										Type:        schema.TypeString,
+										Description: "Network ID",
+										Computed:    true,
+									},
+									"gateway_ip": {
+										Type:        schema.TypeString,
+										Description: "Gateway IP",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"public_ipv4": {
+							Type:        schema.TypeString,
+							Description: "Public IPv4 address of the Public Cloud instance",
+							Computed:    true,
+						},
+						"flavor_vcpus": {
+							Type:        schema.TypeInt,
+							Description: "Number of virtual CPUs of the flavor",
+							Computed:    true,
+						},
+						"flavor_ram": {
+							Type:        schema.TypeInt,
+							Description: "Amount of RAM of the flavor in MB",
+							Computed:    true,
+						},
+						"flavor_disk": {
+							Type:        schema.TypeInt,
+							Description: "Disk size of the flavor in GB",
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -116,12 +191,27 @@ func dataSourceCloudProjectKubeNodesRead(d *schema.ResourceData, meta interface{
 		return helpers.CheckDeleted(d, err, endpoint)
 	}
 
+	filters := cloudProjectKubeNodesFilters{
+		NodePoolId: d.Get("node_pool_id").(string),
+		Status:     d.Get("status").(string),
+		Version:    d.Get("version").(string),
+	}
+	if v, ok := d.GetOkExists("is_up_to_date"); ok {
+		isUpToDate := v.(bool)
+		filters.IsUpToDate = &isUpToDate
+	}
+	res = filters.filter(res)
+
 	nodes := make([]map[string]interface{}, len(res))
 	ids := make([]string, len(res))
 
 	for i, node := range res {
 		nodes[i] = node.ToMap()
 		ids = append(ids, node.Id)
+
+		if err := setCloudProjectKubeNodeInstance(config, serviceName, node.InstanceId, nodes[i]); err != nil {
+			return err
+		}
 	}
 
 	// sort.Strings sorts in place, returns nothing
@@ -133,3 +223,82 @@ func dataSourceCloudProjectKubeNodesRead(d *schema.ResourceData, meta interface{
 	log.Printf("[DEBUG] Read nodes: %+v", res)
 	return nil
 }
+
+type cloudProjectKubeNodesFilters struct {
+	NodePoolId string
+	Status     string
+	IsUpToDate *bool
+	Version    string
+}
+
+// filter returns the nodes matching all the filters, empty filters match any node
+func (f cloudProjectKubeNodesFilters) filter(nodes []CloudProjectKubeNodeResponse) []CloudProjectKubeNodeResponse {
+	filtered := make([]CloudProjectKubeNodeResponse, 0, len(nodes))
+	for _, node := range nodes {
+		if f.NodePoolId != "" && node.NodePoolId != f.NodePoolId {
+			continue
+		}
+		if f.Status != "" && node.Status != f.Status {
+			continue
+		}
+		if f.IsUpToDate != nil && node.IsUpToDate != *f.IsUpToDate {
+			continue
+		}
+		if f.Version != "" && node.Version != f.Version {
+			continue
+		}
+		filtered = append(filtered, node)
+	}
+
+	return filtered
+}
+
+// setCloudProjectKubeNodeInstance adds the IP addresses and flavor details of the
+// instance of a node to its attributes. Nodes being installed have no instance yet.
+func setCloudProjectKubeNodeInstance(config *Config, serviceName, instanceId string, node map[string]interface{}) error {
+	ipAddresses := make([]map[string]interface{}, 0)
+	node["ip_addresses"] = ipAddresses
+
+	instance, err := getCloudProjectKubeNodeInstance(config, serviceName, instanceId)
+	if err != nil || instance == nil {
+		return err
+	}
+
+	for _, ip := range instance.IpAddresses {
+		ipAddresses = append(ipAddresses, ip.ToMap())
+	}
+	node["ip_addresses"] = ipAddresses
+	node["public_ipv4"] = instance.PublicIpv4()
+
+	if instance.Flavor != nil {
+		node["flavor_vcpus"] = instance.Flavor.Vcpus
+		node["flavor_ram"] = instance.Flavor.Ram
+		node["flavor_disk"] = instance.Flavor.Disk
+	}
+
+	return nil
+}
+
+// getCloudProjectKubeNodeInstance returns the underlying instance of a node, or nil when the node
+// has no instance yet or when the instance is gone, e.g. while the node is being replaced
+func getCloudProjectKubeNodeInstance(config *Config, serviceName, instanceId string) (*CloudProjectInstanceResponse, error) {
+	if instanceId == "" {
+		return nil, nil
+	}
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(instanceId))
+	instance := &CloudProjectInstanceResponse{}
+
+	log.Printf("[DEBUG] Will read instance %s in project %s", instanceId, serviceName)
+	if err := config.OVHClient.Get(endpoint, instance); err != nil {
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+			log.Printf("[WARN] instance %s not found, it may be being replaced", instanceId)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+
+	return instance, nil
+}
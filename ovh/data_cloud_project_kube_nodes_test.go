@@ -3,12 +3,45 @@ package ovh
 import (
 	"fmt"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
+func Test_cloudProjectKubeNodesFilters(t *testing.T) {
+	nodes := []CloudProjectKubeNodeResponse{
+		{Id: "n1", NodePoolId: "p1", Status: "READY", IsUpToDate: true, Version: "1.30"},
+		{Id: "n2", NodePoolId: "p1", Status: "INSTALLING", IsUpToDate: false, Version: "1.29"},
+		{Id: "n3", NodePoolId: "p2", Status: "READY", IsUpToDate: false, Version: "1.29"},
+	}
+	notUpToDate := false
+
+	tests := []struct {
+		name    string
+		filters cloudProjectKubeNodesFilters
+		want    []string
+	}{
+		{name: "no filter", filters: cloudProjectKubeNodesFilters{}, want: []string{"n1", "n2", "n3"}},
+		{name: "nodepool", filters: cloudProjectKubeNodesFilters{NodePoolId: "p1"}, want: []string{"n1", "n2"}},
+		{name: "status", filters: cloudProjectKubeNodesFilters{Status: "READY"}, want: []string{"n1", "n3"}},
+		{name: "not up to date", filters: cloudProjectKubeNodesFilters{IsUpToDate: &notUpToDate}, want: []string{"n2", "n3"}},
+		{name: "combined", filters: cloudProjectKubeNodesFilters{Status: "READY", Version: "1.29"}, want: []string{"n3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]string, 0)
+			for _, node := range tt.filters.filter(nodes) {
+				got = append(got, node.Id)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestAccCloudProjectKubeNodesDataSource_basic(t *testing.T) {
 	name := acctest.RandomWithPrefix(test_prefix)
 	region := os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST")
@@ -53,6 +86,12 @@ func TestAccCloudProjectKubeNodesDataSource_basic(t *testing.T) {
 						"data.ovh_cloud_project_kube_nodes.nodesDataSource", "nodes.0.flavor", "b2-7"),
 					resource.TestCheckResourceAttr(
 						"data.ovh_cloud_project_kube_nodes.nodesDataSource", "nodes.0.project_id", os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_cloud_project_kube_nodes.nodesDataSource", "nodes.0.public_ipv4"),
+					resource.TestCheckResourceAttr(
+						"data.ovh_cloud_project_kube_nodes.nodesDataSource", "nodes.0.flavor_vcpus", "2"),
+					resource.TestCheckResourceAttr(
+						"data.ovh_cloud_project_kube_nodes.filtered", "nodes.#", "1"),
 				),
 			},
 		},
@@ -88,4 +127,11 @@ data "ovh_cloud_project_kube_nodes" "nodesDataSource" {
     ovh_cloud_project_kube_nodepool.pool
   ]
 }
+
+data "ovh_cloud_project_kube_nodes" "filtered" {
+  service_name  = ovh_cloud_project_kube.cluster.service_name
+  kube_id       = ovh_cloud_project_kube.cluster.id
+  node_pool_id  = ovh_cloud_project_kube_nodepool.pool.id
+  status        = "READY"
+}
 `
@@ -0,0 +1,134 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func dataSourceCloudProjectKubeOIDCKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudProjectKubeOIDCKubeconfigRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "Service name",
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+			},
+			"kube_id": {
+				Type:        schema.TypeString,
+				Description: "Kube ID",
+				Required:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the cluster and context in the kubeconfig file, defaults to the name of the cluster",
+				Optional:    true,
+				Computed:    true,
+			},
+			"extra_scopes": {
+				Type:        schema.TypeList,
+				Description: "Scopes to request in addition to openid",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"command": {
+				Type:        schema.TypeString,
+				Description: "Command run to get a token: kubectl to use the oidc-login plugin, or the path to the kubelogin binary",
+				Optional:    true,
+				Default:     "kubectl",
+			},
+
+			// Computed
+			"host": {
+				Type:        schema.TypeString,
+				Description: "Kubernetes API server URL",
+				Computed:    true,
+			},
+			"cluster_ca_certificate": {
+				Type:        schema.TypeString,
+				Description: "Kubernetes API server CA certificate",
+				Computed:    true,
+			},
+			"issuer_url": {
+				Type:        schema.TypeString,
+				Description: "OIDC issuer URL configured on the cluster",
+				Computed:    true,
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Description: "OIDC client ID configured on the cluster",
+				Computed:    true,
+			},
+			"kubeconfig": {
+				Type:        schema.TypeString,
+				Description: "Kubeconfig file authenticating through OIDC",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceCloudProjectKubeOIDCKubeconfigRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	kubeId := d.Get("kube_id").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/openIdConnect", url.PathEscape(serviceName), url.PathEscape(kubeId))
+	oidc := &CloudProjectKubeOIDCResponse{}
+
+	log.Printf("[DEBUG] Will read OIDC from kube %s and project: %s", kubeId, serviceName)
+	if err := config.OVHClient.Get(endpoint, oidc); err != nil {
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+			return fmt.Errorf("OIDC is not configured on kube %s, see ovh_cloud_project_kube_oidc", kubeId)
+		}
+		return fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Will read kubeconfig of kube %s from project %s", kubeId, serviceName)
+	adminKubeconfig, err := getKubeconfig(config, serviceName, kubeId)
+	if err != nil {
+		return err
+	}
+	if len(adminKubeconfig.Clusters) == 0 {
+		return fmt.Errorf("kubeconfig is invalid")
+	}
+	cluster := adminKubeconfig.Clusters[0]
+
+	name := d.Get("name").(string)
+	if name == "" {
+		name = cluster.Name
+	}
+
+	extraScopes := make([]string, 0)
+	for _, scope := range d.Get("extra_scopes").([]interface{}) {
+		extraScopes = append(extraScopes, scope.(string))
+	}
+
+	kubeconfig, err := renderKubeconfig(newOIDCKubeconfig(
+		name,
+		cluster.Cluster.Server,
+		cluster.Cluster.CertificateAuthorityData,
+		d.Get("command").(string),
+		oidc.IssuerUrl,
+		oidc.ClientID,
+		extraScopes,
+	))
+	if err != nil {
+		return fmt.Errorf("rendering OIDC kubeconfig: %w", err)
+	}
+
+	d.SetId(kubeId + "-" + oidc.ClientID + "-" + oidc.IssuerUrl)
+	d.Set("name", name)
+	d.Set("host", cluster.Cluster.Server)
+	d.Set("cluster_ca_certificate", cluster.Cluster.CertificateAuthorityData)
+	d.Set("issuer_url", oidc.IssuerUrl)
+	d.Set("client_id", oidc.ClientID)
+	d.Set("kubeconfig", kubeconfig)
+
+	return nil
+}
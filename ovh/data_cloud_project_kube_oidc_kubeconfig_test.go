@@ -0,0 +1,106 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_newOIDCKubeconfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		command string
+		want    []string
+	}{
+		{
+			name:    "kubectl plugin",
+			command: "kubectl",
+			want:    []string{"oidc-login", "get-token", "--oidc-issuer-url=https://issuer", "--oidc-client-id=my-client", "--oidc-extra-scope=email"},
+		},
+		{
+			name:    "kubelogin binary",
+			command: "kubelogin",
+			want:    []string{"get-token", "--oidc-issuer-url=https://issuer", "--oidc-client-id=my-client", "--oidc-extra-scope=email"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newOIDCKubeconfig("foo", "https://foo.bar", "Zm9vCg==", tt.command, "https://issuer", "my-client", []string{"email"})
+
+			if got.CurrentContext != "foo" || got.Contexts[0].Context.User != got.Users[0].Name {
+				t.Errorf("newOIDCKubeconfig() context = %+v, want a context using the OIDC user", got.Contexts[0])
+			}
+			if got.Clusters[0].Cluster.Server != "https://foo.bar" || got.Clusters[0].Cluster.CertificateAuthorityData != "Zm9vCg==" {
+				t.Errorf("newOIDCKubeconfig() cluster = %+v", got.Clusters[0].Cluster)
+			}
+			exec := got.Users[0].User.Exec
+			if exec == nil || exec.Command != tt.command || !reflect.DeepEqual(exec.Args, tt.want) {
+				t.Errorf("newOIDCKubeconfig() exec = %+v, want args %v", exec, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccCloudProjectKubeOIDCKubeconfigDataSource_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	config := fmt.Sprintf(
+		testAccCloudProjectKubeOIDCKubeconfigDataSourceConfig,
+		os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST"),
+		name,
+		os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckKubernetes(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.ovh_cloud_project_kube_oidc_kubeconfig.kubeconfig", "client_id", "my-oidc-client-id"),
+					resource.TestCheckResourceAttr(
+						"data.ovh_cloud_project_kube_oidc_kubeconfig.kubeconfig", "issuer_url", "https://www.ovhcloud.com/fr/"),
+					resource.TestCheckResourceAttrPair(
+						"data.ovh_cloud_project_kube_oidc_kubeconfig.kubeconfig", "host",
+						"ovh_cloud_project_kube.cluster", "kubeconfig_attributes.0.host"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_cloud_project_kube_oidc_kubeconfig.kubeconfig", "kubeconfig"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCloudProjectKubeOIDCKubeconfigDataSourceConfig = `
+resource "ovh_cloud_project_kube" "cluster" {
+	service_name  = "%s"
+	name          = "%s"
+	region        = "%s"
+}
+
+resource "ovh_cloud_project_kube_oidc" "oidc" {
+	service_name  = ovh_cloud_project_kube.cluster.service_name
+	kube_id       = ovh_cloud_project_kube.cluster.id
+
+	client_id  = "my-oidc-client-id"
+	issuer_url = "https://www.ovhcloud.com/fr/"
+}
+
+data "ovh_cloud_project_kube_oidc_kubeconfig" "kubeconfig" {
+	service_name = ovh_cloud_project_kube.cluster.service_name
+	kube_id      = ovh_cloud_project_kube.cluster.id
+	extra_scopes = ["email", "groups"]
+
+	depends_on = [
+		ovh_cloud_project_kube_oidc.oidc
+	]
+}
+`
@@ -0,0 +1,53 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudProjectKubeRegions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudProjectKubeRegionsRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "Service name",
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+			},
+
+			// Computed
+			"names": {
+				Type:        schema.TypeList,
+				Description: "Regions where a managed Kubernetes cluster can be created",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceCloudProjectKubeRegionsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/capabilities/kube/regions", url.PathEscape(serviceName))
+	names := make([]string, 0)
+
+	log.Printf("[DEBUG] Will read kube regions for project %s", serviceName)
+	if err := config.OVHClient.Get(endpoint, &names); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+
+	sort.Strings(names)
+
+	d.SetId(serviceName)
+	d.Set("names", names)
+
+	log.Printf("[DEBUG] Read kube regions: %+v", names)
+	return nil
+}
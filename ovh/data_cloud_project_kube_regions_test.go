@@ -0,0 +1,28 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudProjectKubeRegionsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckCloud(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudProjectKubeRegionsDatasourceConfig,
+				Check:  resource.TestCheckResourceAttrSet("data.ovh_cloud_project_kube_regions.regions", "names.#"),
+			},
+		},
+	})
+}
+
+var testAccCloudProjectKubeRegionsDatasourceConfig = fmt.Sprintf(`
+data "ovh_cloud_project_kube_regions" "regions" {
+  service_name = "%s"
+}
+`, os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST"))
@@ -0,0 +1,82 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudProjectKubeVersions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudProjectKubeVersionsRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "Service name",
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+			},
+
+			// Computed
+			"names": {
+				Type:        schema.TypeList,
+				Description: "Kubernetes versions available for a managed Kubernetes cluster",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"versions": {
+				Type:        schema.TypeList,
+				Description: "Kubernetes versions available for a managed Kubernetes cluster, with their support dates",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Type:        schema.TypeString,
+							Description: "Kubernetes version",
+							Computed:    true,
+						},
+						"end_of_standard_support": {
+							Type:        schema.TypeString,
+							Description: "End of standard support date, when available",
+							Computed:    true,
+						},
+						"end_of_life": {
+							Type:        schema.TypeString,
+							Description: "End of life date, when available",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudProjectKubeVersionsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/capabilities/kube/versions", url.PathEscape(serviceName))
+	res := make([]CloudProjectKubeVersion, 0)
+
+	log.Printf("[DEBUG] Will read kube versions for project %s", serviceName)
+	if err := config.OVHClient.Get(endpoint, &res); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+
+	names := make([]string, len(res))
+	versions := make([]map[string]interface{}, len(res))
+	for i, version := range res {
+		names[i] = version.Version
+		versions[i] = version.ToMap()
+	}
+
+	d.SetId(serviceName)
+	d.Set("names", names)
+	d.Set("versions", versions)
+
+	log.Printf("[DEBUG] Read kube versions: %+v", res)
+	return nil
+}
@@ -0,0 +1,50 @@
+package ovh
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestCloudProjectKubeVersion_UnmarshalJSON(t *testing.T) {
+	eol := "2025-06-30"
+
+	var got []CloudProjectKubeVersion
+	if err := json.Unmarshal([]byte(`["1.29",{"version":"1.30","endOfLife":"2025-06-30"}]`), &got); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []CloudProjectKubeVersion{
+		{Version: "1.29"},
+		{Version: "1.30", EndOfLife: &eol},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("UnmarshalJSON() = %+v, want %+v", got, want)
+	}
+}
+
+func TestAccCloudProjectKubeVersionsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckCloud(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudProjectKubeVersionsDatasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_project_kube_versions.versions", "names.#"),
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_project_kube_versions.versions", "versions.0.version"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCloudProjectKubeVersionsDatasourceConfig = fmt.Sprintf(`
+data "ovh_cloud_project_kube_versions" "versions" {
+  service_name = "%s"
+}
+`, os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST"))
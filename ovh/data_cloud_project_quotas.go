@@ -0,0 +1,199 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers/hashcode"
+)
+
+func dataSourceCloudProjectQuotas() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudProjectQuotasRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "Service name",
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Description: "Only return the quotas of this region",
+				Optional:    true,
+			},
+
+			// Computed
+			"quotas": {
+				Type:        schema.TypeList,
+				Description: "Quotas of the regions of the project",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:        schema.TypeString,
+							Description: "Region of the quotas",
+							Computed:    true,
+						},
+						"max_instances": {
+							Type:        schema.TypeInt,
+							Description: "Maximum number of instances",
+							Computed:    true,
+						},
+						"used_instances": {
+							Type:        schema.TypeInt,
+							Description: "Number of instances",
+							Computed:    true,
+						},
+						"max_cores": {
+							Type:        schema.TypeInt,
+							Description: "Maximum number of cores",
+							Computed:    true,
+						},
+						"used_cores": {
+							Type:        schema.TypeInt,
+							Description: "Number of cores used by the instances",
+							Computed:    true,
+						},
+						"max_ram": {
+							Type:        schema.TypeInt,
+							Description: "Maximum RAM in MB",
+							Computed:    true,
+						},
+						"used_ram": {
+							Type:        schema.TypeInt,
+							Description: "RAM in MB used by the instances",
+							Computed:    true,
+						},
+						"max_volumes": {
+							Type:        schema.TypeInt,
+							Description: "Maximum number of volumes",
+							Computed:    true,
+						},
+						"used_volumes": {
+							Type:        schema.TypeInt,
+							Description: "Number of volumes",
+							Computed:    true,
+						},
+						"max_volume_gigabytes": {
+							Type:        schema.TypeInt,
+							Description: "Maximum size in GB of the volumes",
+							Computed:    true,
+						},
+						"used_volume_gigabytes": {
+							Type:        schema.TypeInt,
+							Description: "Size in GB of the volumes",
+							Computed:    true,
+						},
+						"max_floating_ips": {
+							Type:        schema.TypeInt,
+							Description: "Maximum number of floating IPs",
+							Computed:    true,
+						},
+						"used_floating_ips": {
+							Type:        schema.TypeInt,
+							Description: "Number of floating IPs",
+							Computed:    true,
+						},
+						"max_gateways": {
+							Type:        schema.TypeInt,
+							Description: "Maximum number of gateways",
+							Computed:    true,
+						},
+						"used_gateways": {
+							Type:        schema.TypeInt,
+							Description: "Number of gateways",
+							Computed:    true,
+						},
+						"max_networks": {
+							Type:        schema.TypeInt,
+							Description: "Maximum number of private networks",
+							Computed:    true,
+						},
+						"used_networks": {
+							Type:        schema.TypeInt,
+							Description: "Number of private networks",
+							Computed:    true,
+						},
+						"max_loadbalancers": {
+							Type:        schema.TypeInt,
+							Description: "Maximum number of load balancers",
+							Computed:    true,
+						},
+						"used_loadbalancers": {
+							Type:        schema.TypeInt,
+							Description: "Number of load balancers",
+							Computed:    true,
+						},
+						"used_kube_clusters": {
+							Type:        schema.TypeInt,
+							Description: "Number of managed kubernetes clusters, the API exposing no maximum",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudProjectQuotasRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	region := d.Get("region").(string)
+
+	res, err := getCloudProjectQuotas(config.OVHClient, serviceName)
+	if err != nil {
+		return err
+	}
+
+	kubeClusters, err := countCloudProjectKubeClustersByRegion(config, serviceName)
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Region < res[j].Region })
+
+	quotas := make([]map[string]interface{}, 0, len(res))
+	regions := []string{serviceName, region}
+	for _, quota := range res {
+		if region != "" && quota.Region != region {
+			continue
+		}
+		obj := quota.ToMap()
+		obj["used_kube_clusters"] = kubeClusters[quota.Region]
+		quotas = append(quotas, obj)
+		regions = append(regions, quota.Region)
+	}
+
+	d.SetId(hashcode.Strings(regions))
+	d.Set("quotas", quotas)
+
+	log.Printf("[DEBUG] Read quotas: %+v", res)
+	return nil
+}
+
+// countCloudProjectKubeClustersByRegion returns the number of kube clusters of the project in each region
+func countCloudProjectKubeClustersByRegion(config *Config, serviceName string) (map[string]int, error) {
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube", url.PathEscape(serviceName))
+	var ids []string
+
+	log.Printf("[DEBUG] Will read kube clusters of project %s", serviceName)
+	if err := config.OVHClient.Get(endpoint, &ids); err != nil {
+		return nil, fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+
+	res := make(map[string]int)
+	for _, id := range ids {
+		kube := &CloudProjectKubeResponse{}
+		endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s", url.PathEscape(serviceName), url.PathEscape(id))
+		if err := config.OVHClient.Get(endpoint, kube); err != nil {
+			return nil, fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+		}
+		res[kube.Region]++
+	}
+	return res, nil
+}
@@ -0,0 +1,100 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestCloudProjectQuotaRequest_Exceeded(t *testing.T) {
+	quota := &CloudProjectQuotaResponse{
+		Region: "GRA9",
+		Instance: &CloudProjectQuotaInstance{
+			MaxInstances:  20,
+			UsedInstances: 18,
+			MaxCores:      40,
+			UsedCores:     36,
+			MaxRam:        81920,
+			UsedRam:       40960,
+		},
+	}
+
+	tests := []struct {
+		name  string
+		req   CloudProjectQuotaRequest
+		quota *CloudProjectQuotaResponse
+		want  []string
+	}{
+		{
+			name:  "fits",
+			req:   CloudProjectQuotaRequest{Instances: 2, Cores: 4, Ram: 14336},
+			quota: quota,
+			want:  nil,
+		},
+		{
+			name:  "too many instances and cores",
+			req:   CloudProjectQuotaRequest{Instances: 3, Cores: 6, Ram: 21504},
+			quota: quota,
+			want: []string{
+				"3 instances requested in region GRA9 but only 2 left (18/20 used)",
+				"6 cores requested in region GRA9 but only 4 left (36/40 used)",
+			},
+		},
+		{
+			name:  "quota already exceeded",
+			req:   CloudProjectQuotaRequest{Ram: 1024},
+			quota: &CloudProjectQuotaResponse{Region: "GRA9", Instance: &CloudProjectQuotaInstance{MaxRam: 2048, UsedRam: 4096}},
+			want:  []string{"1024 MB of RAM requested in region GRA9 but only 0 left (4096/2048 used)"},
+		},
+		{
+			name:  "downsize",
+			req:   CloudProjectQuotaRequest{Cores: -4, Ram: -8192},
+			quota: quota,
+			want:  nil,
+		},
+		{
+			name:  "no instance quota",
+			req:   CloudProjectQuotaRequest{Instances: 1},
+			quota: &CloudProjectQuotaResponse{Region: "GRA9"},
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.req.Exceeded(tt.quota); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Exceeded() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+var testAccCloudProjectQuotasConfig = `
+data "ovh_cloud_project_quotas" "quotas" {
+  service_name = "%s"
+  region       = "%s"
+}
+`
+
+func TestAccCloudProjectQuotasDataSource_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	region := os.Getenv("OVH_CLOUD_PROJECT_REGION_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckCloudRegion(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudProjectQuotasConfig, serviceName, region),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_cloud_project_quotas.quotas", "quotas.#", "1"),
+					resource.TestCheckResourceAttr("data.ovh_cloud_project_quotas.quotas", "quotas.0.region", region),
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_project_quotas.quotas", "quotas.0.max_instances"),
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_project_quotas.quotas", "quotas.0.used_kube_clusters"),
+				),
+			},
+		},
+	})
+}
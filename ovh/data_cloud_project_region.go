@@ -53,6 +53,11 @@ func dataSourceCloudProjectRegion() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -72,6 +77,7 @@ func dataSourceCloudProjectRegionRead(d *schema.ResourceData, meta interface{})
 
 	d.Set("datacenter_location", region.DatacenterLocation)
 	d.Set("continent_code", region.ContinentCode)
+	d.Set("availability_zones", region.AvailabilityZones)
 
 	services := &schema.Set{
 		F: cloudServiceHash,
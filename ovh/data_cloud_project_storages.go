@@ -0,0 +1,117 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers/hashcode"
+)
+
+func dataSourceCloudProjectStorages() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudProjectStoragesRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "Service name",
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+			},
+			"region_name": {
+				Type:        schema.TypeString,
+				Description: "Region name",
+				Required:    true,
+			},
+
+			// Computed
+			"containers": {
+				Type:        schema.TypeList,
+				Description: "S3 storage containers of the region",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the container",
+							Computed:    true,
+						},
+						"owner_id": {
+							Type:        schema.TypeInt,
+							Description: "ID of the cloud project user owning the container",
+							Computed:    true,
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Description: "Creation date of the container",
+							Computed:    true,
+						},
+						"objects_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of objects in the container",
+							Computed:    true,
+						},
+						"objects_size": {
+							Type:        schema.TypeInt,
+							Description: "Total size of the objects in the container, in bytes",
+							Computed:    true,
+						},
+						"virtual_host": {
+							Type:        schema.TypeString,
+							Description: "Virtual host of the container",
+							Computed:    true,
+						},
+						"tags": {
+							Type:        schema.TypeMap,
+							Description: "Tags of the container",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudProjectStoragesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/region/%s/storage",
+		url.PathEscape(serviceName),
+		url.PathEscape(regionName))
+	var res []CloudProjectStorageResponse
+
+	log.Printf("[DEBUG] Will read storage containers of project %s in region %s", serviceName, regionName)
+	if err := config.OVHClient.Get(endpoint, &res); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+
+	containers := make([]map[string]interface{}, len(res))
+	names := make([]string, len(res))
+	for i, container := range res {
+		containers[i] = map[string]interface{}{
+			"name":          container.Name,
+			"owner_id":      container.OwnerId,
+			"created_at":    container.CreatedAt,
+			"objects_count": container.ObjectsCount,
+			"objects_size":  container.ObjectsSize,
+			"virtual_host":  container.VirtualHost,
+			"tags":          container.Tags,
+		}
+		names[i] = container.Name
+	}
+
+	d.SetId(hashcode.Strings(append([]string{serviceName, regionName}, names...)))
+	d.Set("containers", containers)
+
+	log.Printf("[DEBUG] Read storage containers: %+v", res)
+	return nil
+}
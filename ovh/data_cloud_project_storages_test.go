@@ -0,0 +1,48 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testAccDataSourceCloudProjectStoragesConfig = `
+resource "ovh_cloud_project_storage" "storage" {
+  service_name = "%s"
+  region_name  = "%s"
+  name         = "%s"
+}
+
+data "ovh_cloud_project_storages" "storages" {
+  service_name = ovh_cloud_project_storage.storage.service_name
+  region_name  = ovh_cloud_project_storage.storage.region_name
+}
+
+output "found" {
+  value = contains(data.ovh_cloud_project_storages.storages.containers[*].name, ovh_cloud_project_storage.storage.name)
+}
+`
+
+func TestAccDataSourceCloudProjectStorages_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	regionName := os.Getenv("OVH_CLOUD_PROJECT_STORAGE_REGION_TEST")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloudStorage(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceCloudProjectStoragesConfig, serviceName, regionName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("found", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,99 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudProjectUsage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudProjectUsageRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "Service name",
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+			},
+
+			// Computed
+			"last_update": {
+				Type:        schema.TypeString,
+				Description: "Last update date of the usage",
+				Computed:    true,
+			},
+			"period_from": {
+				Type:        schema.TypeString,
+				Description: "Start date of the current month",
+				Computed:    true,
+			},
+			"period_to": {
+				Type:        schema.TypeString,
+				Description: "End date of the current month",
+				Computed:    true,
+			},
+			"total_price": {
+				Type:        schema.TypeFloat,
+				Description: "Price of the resources consumed since the start of the month",
+				Computed:    true,
+			},
+			"resources_usage": {
+				Type:        schema.TypeList,
+				Description: "Price of the resources consumed since the start of the month, by type of resource",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Description: "Type of resource",
+							Computed:    true,
+						},
+						"total_price": {
+							Type:        schema.TypeFloat,
+							Description: "Price of the resources of this type",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"forecast_total_price": {
+				Type:        schema.TypeFloat,
+				Description: "Forecasted price of the resources at the end of the month",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceCloudProjectUsageRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/usage/current", url.PathEscape(serviceName))
+	current := &CloudProjectUsageResponse{}
+
+	log.Printf("[DEBUG] Will read current usage of project %s", serviceName)
+	if err := config.OVHClient.Get(endpoint, current); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+
+	endpoint = fmt.Sprintf("/cloud/project/%s/usage/forecast", url.PathEscape(serviceName))
+	forecast := &CloudProjectUsageResponse{}
+
+	log.Printf("[DEBUG] Will read usage forecast of project %s", serviceName)
+	if err := config.OVHClient.Get(endpoint, forecast); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serviceName, current.Period.From))
+	for k, v := range current.ToMap() {
+		d.Set(k, v)
+	}
+	d.Set("forecast_total_price", forecast.TotalPrice())
+
+	log.Printf("[DEBUG] Read usage: %+v, forecast: %+v", current, forecast)
+	return nil
+}
@@ -0,0 +1,47 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestCloudProjectUsageResponse_TotalPrice(t *testing.T) {
+	usage := CloudProjectUsageResponse{
+		ResourcesUsage: []CloudProjectUsageResourcesUsage{
+			{Type: "instance", TotalPrice: 12.5},
+			{Type: "storage", TotalPrice: 0.25},
+		},
+	}
+	if got := usage.TotalPrice(); got != 12.75 {
+		t.Errorf("TotalPrice() = %v, want 12.75", got)
+	}
+	if got := (CloudProjectUsageResponse{}).TotalPrice(); got != 0 {
+		t.Errorf("TotalPrice() = %v, want 0", got)
+	}
+}
+
+var testAccCloudProjectUsageConfig = `
+data "ovh_cloud_project_usage" "usage" {
+  service_name = "%s"
+}
+`
+
+func TestAccCloudProjectUsageDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckCloud(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudProjectUsageConfig, os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_project_usage.usage", "period_from"),
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_project_usage.usage", "total_price"),
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_project_usage.usage", "forecast_total_price"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,130 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers/hashcode"
+)
+
+func dataSourceCloudProjectVolumes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudProjectVolumesRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "Service name",
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Description: "Only return the volumes of this region",
+				Optional:    true,
+			},
+
+			// Computed
+			"volumes": {
+				Type:        schema.TypeList,
+				Description: "Volumes of the project",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "ID of the volume",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the volume",
+							Computed:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "Description of the volume",
+							Computed:    true,
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Description: "Size of the volume in GB",
+							Computed:    true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Description: "Type of the volume",
+							Computed:    true,
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Description: "Region of the volume",
+							Computed:    true,
+						},
+						"availability_zone": {
+							Type:        schema.TypeString,
+							Description: "Availability zone of the volume",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "Status of the volume",
+							Computed:    true,
+						},
+						"bootable": {
+							Type:        schema.TypeBool,
+							Description: "True if the volume is bootable",
+							Computed:    true,
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Description: "Creation date of the volume",
+							Computed:    true,
+						},
+						"attached_to": {
+							Type:        schema.TypeList,
+							Description: "IDs of the instances the volume is attached to",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudProjectVolumesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	region := d.Get("region").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume", url.PathEscape(serviceName))
+	if region != "" {
+		endpoint += "?region=" + url.QueryEscape(region)
+	}
+	var res []CloudProjectVolumeResponse
+
+	log.Printf("[DEBUG] Will read volumes of project %s", serviceName)
+	if err := config.OVHClient.Get(endpoint, &res); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Id < res[j].Id })
+
+	volumes := make([]map[string]interface{}, len(res))
+	ids := make([]string, len(res))
+	for i, volume := range res {
+		volumes[i] = volume.ToMap()
+		ids[i] = volume.Id
+	}
+
+	d.SetId(hashcode.Strings(append([]string{serviceName, region}, ids...)))
+	d.Set("volumes", volumes)
+
+	log.Printf("[DEBUG] Read volumes: %+v", res)
+	return nil
+}
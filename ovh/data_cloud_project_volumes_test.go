@@ -0,0 +1,50 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testAccCloudProjectVolumesDataSourceConfig = `
+resource "ovh_cloud_project_volume" "volume" {
+  service_name = "%s"
+  region       = "%s"
+  name         = "%s"
+  size         = 10
+}
+
+data "ovh_cloud_project_volumes" "volumes" {
+  service_name = ovh_cloud_project_volume.volume.service_name
+  region       = ovh_cloud_project_volume.volume.region
+}
+
+output "volume_found" {
+  value = contains(data.ovh_cloud_project_volumes.volumes.volumes[*].id, ovh_cloud_project_volume.volume.id)
+}
+`
+
+func TestAccCloudProjectVolumesDataSource_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	region := os.Getenv("OVH_CLOUD_PROJECT_REGION_TEST")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloudRegion(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudProjectVolumesDataSourceConfig, serviceName, region, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_project_volumes.volumes", "volumes.#"),
+					resource.TestCheckOutput("volume_found", "true"),
+				),
+			},
+		},
+	})
+}
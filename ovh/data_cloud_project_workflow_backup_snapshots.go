@@ -0,0 +1,133 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers/hashcode"
+)
+
+func dataSourceCloudProjectWorkflowBackupSnapshots() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudProjectWorkflowBackupSnapshotsRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "Service name",
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+			},
+			"region_name": {
+				Type:        schema.TypeString,
+				Description: "Region of the workflow",
+				Required:    true,
+			},
+			"workflow_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the backup workflow",
+				Required:    true,
+			},
+
+			// Computed
+			"backup_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the snapshots taken by the workflow",
+				Computed:    true,
+			},
+			"snapshots": {
+				Type:        schema.TypeList,
+				Description: "Snapshots taken by the workflow, the most recent first",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "ID of the snapshot",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the snapshot",
+							Computed:    true,
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Description: "Region of the snapshot",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "Status of the snapshot",
+							Computed:    true,
+						},
+						"creation_date": {
+							Type:        schema.TypeString,
+							Description: "Creation date of the snapshot",
+							Computed:    true,
+						},
+						"min_disk": {
+							Type:        schema.TypeInt,
+							Description: "Minimum disk size in GB to restore the snapshot",
+							Computed:    true,
+						},
+						"size": {
+							Type:        schema.TypeFloat,
+							Description: "Size of the snapshot in GB",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudProjectWorkflowBackupSnapshotsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+	workflowId := d.Get("workflow_id").(string)
+
+	endpoint := fmt.Sprintf(OVH_CLOUD_PROJECT_WORKFLOW_BACKUP_ENDPOINT, url.PathEscape(serviceName), url.PathEscape(regionName)) +
+		"/" + url.PathEscape(workflowId)
+	workflow := &CloudProjectWorkflowBackupResponse{}
+
+	log.Printf("[DEBUG] Will read workflow backup %s", endpoint)
+	if err := config.OVHClient.Get(endpoint, workflow); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+
+	endpoint = fmt.Sprintf("/cloud/project/%s/snapshot?region=%s", url.PathEscape(serviceName), url.QueryEscape(regionName))
+	var res []CloudProjectSnapshotResponse
+
+	log.Printf("[DEBUG] Will read snapshots of region %s in project %s", regionName, serviceName)
+	if err := config.OVHClient.Get(endpoint, &res); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	snapshots := make([]CloudProjectSnapshotResponse, 0)
+	for _, snapshot := range res {
+		if cloudProjectWorkflowBackupSnapshotMatch(workflow.BackupName, snapshot.Name) {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+	sort.SliceStable(snapshots, func(i, j int) bool { return snapshots[i].CreationDate > snapshots[j].CreationDate })
+
+	mapSnapshots := make([]map[string]interface{}, len(snapshots))
+	ids := make([]string, len(snapshots))
+	for i, snapshot := range snapshots {
+		mapSnapshots[i] = snapshot.ToMap()
+		ids[i] = snapshot.Id
+	}
+
+	d.SetId(hashcode.Strings(append([]string{serviceName, regionName, workflowId}, ids...)))
+	d.Set("backup_name", workflow.BackupName)
+	d.Set("snapshots", mapSnapshots)
+
+	log.Printf("[DEBUG] Read snapshots of workflow %s: %+v", workflowId, snapshots)
+	return nil
+}
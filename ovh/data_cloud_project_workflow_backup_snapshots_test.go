@@ -0,0 +1,76 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_cloudProjectWorkflowBackupSnapshotMatch(t *testing.T) {
+	tests := []struct {
+		backupName, snapshotName string
+		want                     bool
+	}{
+		{"backup", "backup", true},
+		{"backup", "backup 2024-05-02T04:50:00Z", true},
+		{"backup", "backup_2024-05-02", true},
+		{"backup", "backup2 2024-05-02", false},
+		{"backup", "other 2024-05-02", false},
+		{"", "backup", false},
+	}
+	for _, tt := range tests {
+		if got := cloudProjectWorkflowBackupSnapshotMatch(tt.backupName, tt.snapshotName); got != tt.want {
+			t.Errorf("cloudProjectWorkflowBackupSnapshotMatch(%q, %q) = %v, want %v", tt.backupName, tt.snapshotName, got, tt.want)
+		}
+	}
+}
+
+const testAccDataSourceCloudProjectWorkflowBackupSnapshotsConfig = `
+resource "ovh_cloud_project_workflow_backup" "my_backup" {
+  service_name        = "%s"
+  region_name         = "%s"
+  cron                = "50 4 * * *"
+  instance_id         = "%s"
+  max_execution_count = "0"
+  name                = "%s"
+  rotation            = "7"
+}
+
+data "ovh_cloud_project_workflow_backup_snapshots" "snapshots" {
+  service_name = ovh_cloud_project_workflow_backup.my_backup.service_name
+  region_name  = ovh_cloud_project_workflow_backup.my_backup.region_name
+  workflow_id  = ovh_cloud_project_workflow_backup.my_backup.id
+}
+`
+
+func TestAccDataSourceCloudProjectWorkflowBackupSnapshots_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	config := fmt.Sprintf(testAccDataSourceCloudProjectWorkflowBackupSnapshotsConfig,
+		os.Getenv(CLOUD_PROJECT_TEST_ENV_VAR),
+		os.Getenv(WORKFLOW_BACKUP_TEST_REGION_ENV_VAR),
+		os.Getenv(WORKFLOW_BACKUP_TEST_INSTANCE_ID_ENV_VAR),
+		name,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloud(t)
+			testAccCheckCloudProjectExists(t)
+			testAccPreCheckWorkflowBackup(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_cloud_project_workflow_backup_snapshots.snapshots", "backup_name", name),
+					// the workflow hasn't run yet
+					resource.TestCheckResourceAttr("data.ovh_cloud_project_workflow_backup_snapshots.snapshots", "snapshots.#", "0"),
+				),
+			},
+		},
+	})
+}
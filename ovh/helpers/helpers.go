@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/ovh/go-ovh/ovh"
 	"github.com/ybriffa/rfc3339"
+	"golang.org/x/crypto/ssh"
 )
 
 func ValidateIpBlock(value string) error {
@@ -211,6 +212,28 @@ func ValidateRFC3339Duration(i interface{}, _ string) (_ []string, errors []erro
 	return
 }
 
+// ValidateSshPublicKey implements schema.SchemaValidateFunc for SSH public keys
+// in the authorized_keys format, only RSA, ECDSA and Ed25519 keys being accepted.
+func ValidateSshPublicKey(i interface{}, k string) (_ []string, errors []error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(i.(string)))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%s is not a valid SSH public key: %s", k, err))
+		return
+	}
+
+	switch key.Type() {
+	case ssh.KeyAlgoRSA, ssh.KeyAlgoECDSA256, ssh.KeyAlgoECDSA384, ssh.KeyAlgoECDSA521, ssh.KeyAlgoED25519:
+	default:
+		errors = append(errors, fmt.Errorf("%s is a %s key, only RSA, ECDSA and Ed25519 keys are supported", k, key.Type()))
+	}
+	return
+}
+
+// SuppressSshPublicKeyDiff ignores the trailing whitespaces of SSH public keys, usually removed by the API
+func SuppressSshPublicKeyDiff(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
+
 func ValidateDedicatedCephACLFamily(value string) error {
 	return ValidateStringEnum(value, []string{
 		"IPv4",
@@ -114,20 +114,33 @@ func Provider() *schema.Provider {
 			"ovh_cloud_project_database_user":                                dataSourceCloudProjectDatabaseUser(),
 			"ovh_cloud_project_database_users":                               dataSourceCloudProjectDatabaseUsers(),
 			"ovh_cloud_project_failover_ip_attach":                           dataSourceCloudProjectFailoverIpAttach(),
+			"ovh_cloud_project_floating_ip":                                  dataSourceCloudProjectFloatingIp(),
+			"ovh_cloud_project_floating_ips":                                 dataSourceCloudProjectFloatingIps(),
 			"ovh_cloud_project_kube":                                         dataSourceCloudProjectKube(),
+			"ovh_cloud_project_kube_flavors":                                 dataSourceCloudProjectKubeFlavors(),
+			"ovh_cloud_project_kube_health":                                  dataSourceCloudProjectKubeHealth(),
 			"ovh_cloud_project_kube_iprestrictions":                          dataSourceCloudProjectKubeIPRestrictions(),
+			"ovh_cloud_project_kube_merged_kubeconfig":                       dataSourceCloudProjectKubeMergedKubeconfig(),
 			"ovh_cloud_project_kube_nodepool_nodes":                          dataSourceCloudProjectKubeNodepoolNodes(),
 			"ovh_cloud_project_kube_oidc":                                    dataSourceCloudProjectKubeOIDC(),
+			"ovh_cloud_project_kube_oidc_kubeconfig":                         dataSourceCloudProjectKubeOIDCKubeconfig(),
 			"ovh_cloud_project_kube_nodepool":                                dataSourceCloudProjectKubeNodepool(),
 			"ovh_cloud_project_kube_nodes":                                   dataSourceCloudProjectKubeNodes(),
+			"ovh_cloud_project_kube_regions":                                 dataSourceCloudProjectKubeRegions(),
+			"ovh_cloud_project_kube_versions":                                dataSourceCloudProjectKubeVersions(),
+			"ovh_cloud_project_quotas":                                       dataSourceCloudProjectQuotas(),
 			"ovh_cloud_project_region":                                       dataSourceCloudProjectRegion(),
 			"ovh_cloud_project_regions":                                      dataSourceCloudProjectRegions(),
+			"ovh_cloud_project_storages":                                     dataSourceCloudProjectStorages(),
+			"ovh_cloud_project_usage":                                        dataSourceCloudProjectUsage(),
 			"ovh_cloud_project_user":                                         datasourceCloudProjectUser(),
 			"ovh_cloud_project_user_s3_credential":                           dataCloudProjectUserS3Credential(),
 			"ovh_cloud_project_user_s3_credentials":                          dataCloudProjectUserS3Credentials(),
 			"ovh_cloud_project_user_s3_policy":                               dataCloudProjectUserS3Policy(),
 			"ovh_cloud_project_users":                                        datasourceCloudProjectUsers(),
+			"ovh_cloud_project_volumes":                                      dataSourceCloudProjectVolumes(),
 			"ovh_cloud_project_vrack":                                        dataSourceCloudProjectVrack(),
+			"ovh_cloud_project_workflow_backup_snapshots":                    dataSourceCloudProjectWorkflowBackupSnapshots(),
 			"ovh_dbaas_logs_cluster":                                         dataSourceDbaasLogsCluster(),
 			"ovh_dbaas_logs_clusters":                                        dataSourceDbaasLogsClusters(),
 			"ovh_dbaas_logs_input_engine":                                    dataSourceDbaasLogsInputEngine(),
@@ -183,6 +196,10 @@ func Provider() *schema.Provider {
 			"ovh_cloud_project_containerregistry":                            resourceCloudProjectContainerRegistry(),
 			"ovh_cloud_project_containerregistry_oidc":                       resourceCloudProjectContainerRegistryOIDC(),
 			"ovh_cloud_project_containerregistry_user":                       resourceCloudProjectContainerRegistryUser(),
+			"ovh_cloud_project_containerregistry_project":                    resourceCloudProjectContainerRegistryProject(),
+			"ovh_cloud_project_containerregistry_robot":                      resourceCloudProjectContainerRegistryRobot(),
+			"ovh_cloud_project_containerregistry_retention_policy":           resourceCloudProjectContainerRegistryRetentionPolicy(),
+			"ovh_cloud_project_containerregistry_immutability_rule":          resourceCloudProjectContainerRegistryImmutabilityRule(),
 			"ovh_cloud_project_containerregistry_ip_restrictions_management": resourceCloudProjectContainerRegistryIPRestrictionsManagement(),
 			"ovh_cloud_project_containerregistry_ip_restrictions_registry":   resourceCloudProjectContainerRegistryIPRestrictionsRegistry(),
 			"ovh_cloud_project_database":                                     resourceCloudProjectDatabase(),
@@ -203,17 +220,36 @@ func Provider() *schema.Provider {
 			"ovh_cloud_project_database_redis_user":                          resourceCloudProjectDatabaseRedisUser(),
 			"ovh_cloud_project_database_user":                                resourceCloudProjectDatabaseUser(),
 			"ovh_cloud_project_failover_ip_attach":                           resourceCloudProjectFailoverIpAttach(),
+			"ovh_cloud_project_floating_ip":                                  resourceCloudProjectFloatingIp(),
+			"ovh_cloud_project_floating_ip_association":                      resourceCloudProjectFloatingIpAssociation(),
 			"ovh_cloud_project_gateway":                                      resourceCloudProjectGateway(),
+			"ovh_cloud_project_gateway_interface":                            resourceCloudProjectGatewayInterface(),
+			"ovh_cloud_project_instance":                                     resourceCloudProjectInstance(),
+			"ovh_cloud_project_instance_restore":                             resourceCloudProjectInstanceRestore(),
 			"ovh_cloud_project_kube":                                         resourceCloudProjectKube(),
 			"ovh_cloud_project_kube_nodepool":                                resourceCloudProjectKubeNodePool(),
+			"ovh_cloud_project_kube_nodepool_node_operation":                 resourceCloudProjectKubeNodePoolNodeOperation(),
 			"ovh_cloud_project_kube_oidc":                                    resourceCloudProjectKubeOIDC(),
+			"ovh_cloud_project_kube_iprestriction":                           resourceCloudProjectKubeIpRestriction(),
 			"ovh_cloud_project_kube_iprestrictions":                          resourceCloudProjectKubeIpRestrictions(),
+			"ovh_cloud_project_loadbalancer":                                 resourceCloudProjectLoadbalancer(),
+			"ovh_cloud_project_loadbalancer_listener":                        resourceCloudProjectLoadbalancerListener(),
+			"ovh_cloud_project_loadbalancer_member":                          resourceCloudProjectLoadbalancerMember(),
+			"ovh_cloud_project_loadbalancer_pool":                            resourceCloudProjectLoadbalancerPool(),
 			"ovh_cloud_project_network_private":                              resourceCloudProjectNetworkPrivate(),
 			"ovh_cloud_project_network_private_subnet":                       resourceCloudProjectNetworkPrivateSubnet(),
+			"ovh_cloud_project_network_private_subnet_v2":                    resourceCloudProjectNetworkPrivateSubnetV2(),
 			"ovh_cloud_project_region_storage_presign":                       resourceCloudProjectRegionStoragePresign(),
+			"ovh_cloud_project_ssh_key":                                      resourceCloudProjectSshKey(),
+			"ovh_cloud_project_storage":                                      resourceCloudProjectStorage(),
+			"ovh_cloud_project_storage_lifecycle_configuration":              resourceCloudProjectStorageLifecycleConfiguration(),
+			"ovh_cloud_project_storage_replication_configuration":            resourceCloudProjectStorageReplicationConfiguration(),
 			"ovh_cloud_project_user":                                         resourceCloudProjectUser(),
 			"ovh_cloud_project_user_s3_credential":                           resourceCloudProjectUserS3Credential(),
 			"ovh_cloud_project_user_s3_policy":                               resourceCloudProjectUserS3Policy(),
+			"ovh_cloud_project_volume":                                       resourceCloudProjectVolume(),
+			"ovh_cloud_project_volume_attachment":                            resourceCloudProjectVolumeAttachment(),
+			"ovh_cloud_project_volume_snapshot":                              resourceCloudProjectVolumeSnapshot(),
 			"ovh_cloud_project_workflow_backup":                              resourceCloudProjectWorkflowBackup(),
 			"ovh_dbaas_logs_cluster":                                         resourceDbaasLogsCluster(),
 			"ovh_dbaas_logs_input":                                           resourceDbaasLogsInput(),
@@ -243,6 +243,15 @@ func testAccPreCheckContainerRegistry(t *testing.T) {
 	checkEnvOrSkip(t, "OVH_CLOUD_PROJECT_CONTAINERREGISTRY_REGION_TEST")
 }
 
+// Checks that the environment variables needed to call the Harbor API of a registry are set,
+// the registry can be a managed private registry or a local Harbor
+func testAccPreCheckContainerRegistryHarbor(t *testing.T) {
+	testAccPreCheckCredentials(t)
+	checkEnvOrSkip(t, "OVH_CLOUD_PROJECT_CONTAINERREGISTRY_URL_TEST")
+	checkEnvOrSkip(t, "OVH_CLOUD_PROJECT_CONTAINERREGISTRY_USER_TEST")
+	checkEnvOrSkip(t, "OVH_CLOUD_PROJECT_CONTAINERREGISTRY_PASSWORD_TEST")
+}
+
 // Checks that the environment variables needed for the /cloud/{cloudId}/containerregistry/{registryID}/openIdConnect acceptance tests
 // are set.
 func testAccPreCheckContainerRegistryOIDC(t *testing.T) {
@@ -286,11 +295,29 @@ func testAccPreCheckCloudRegion(t *testing.T) {
 	checkEnvOrSkip(t, "OVH_CLOUD_PROJECT_REGION_TEST")
 }
 
+func testAccPreCheckCloudInstance(t *testing.T) {
+	testAccPreCheckCloudRegion(t)
+	checkEnvOrSkip(t, "OVH_CLOUD_PROJECT_INSTANCE_FLAVOR_ID_TEST")
+	checkEnvOrSkip(t, "OVH_CLOUD_PROJECT_INSTANCE_IMAGE_ID_TEST")
+}
+
+func testAccPreCheckCloudStorage(t *testing.T) {
+	testAccPreCheckCloud(t)
+	testAccCheckCloudProjectExists(t)
+	checkEnvOrSkip(t, "OVH_CLOUD_PROJECT_STORAGE_REGION_TEST")
+}
+
 func testAccPreCheckCloudRegionLoadbalancer(t *testing.T) {
 	testAccPreCheckCloudRegion(t)
 	checkEnvOrSkip(t, "OVH_CLOUD_PROJECT_LOADBALANCER_TEST")
 }
 
+func testAccPreCheckCloudLoadbalancing(t *testing.T) {
+	testAccPreCheckCloudRegion(t)
+	checkEnvOrSkip(t, "OVH_VRACK_SERVICE_TEST")
+	checkEnvOrSkip(t, "OVH_CLOUD_PROJECT_LOADBALANCER_FLAVOR_ID_TEST")
+}
+
 // Checks that the environment variables needed for the /cloud/project/{projectId}/ip/failover acceptance tests
 // are set.
 func testAccPreCheckFailoverIpAttach(t *testing.T) {
@@ -311,6 +338,13 @@ func testAccPreCheckKubernetes(t *testing.T) {
 	checkEnvOrSkip(t, "OVH_CLOUD_PROJECT_KUBE_PREV_VERSION_TEST")
 }
 
+// Checks that the environment variables needed for the multi availability zones kubernetes acceptance tests
+// are set.
+func testAccPreCheckKubernetesMultiAZ(t *testing.T) {
+	testAccPreCheckKubernetes(t)
+	checkEnvOrSkip(t, "OVH_CLOUD_PROJECT_KUBE_MULTI_AZ_REGION_TEST")
+}
+
 // Checks that the environment variables needed for the /vrack/{service}/cloudProject acceptance tests
 // are set.
 func testAccPreCheckKubernetesVRack(t *testing.T) {
@@ -0,0 +1,130 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudProjectContainerRegistryImmutabilityRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudProjectContainerRegistryImmutabilityRuleCreate,
+		ReadContext:   resourceCloudProjectContainerRegistryImmutabilityRuleRead,
+		UpdateContext: resourceCloudProjectContainerRegistryImmutabilityRuleUpdate,
+		DeleteContext: resourceCloudProjectContainerRegistryImmutabilityRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudProjectContainerRegistryImmutabilityRuleImportState,
+		},
+
+		Schema: harborResourceSchema(harborTagRuleSchema(map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeInt,
+				Description: "ID of the registry project",
+				Required:    true,
+				ForceNew:    true,
+			},
+		})),
+	}
+}
+
+func resourceCloudProjectContainerRegistryImmutabilityRuleImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	splitId := strings.SplitN(d.Id(), "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("import id is not project_id/rule_id formatted")
+	}
+
+	projectId, err := strconv.Atoi(splitId[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid project id %q: %s", splitId[0], err)
+	}
+
+	if err := harborImportRegistry(d); err != nil {
+		return nil, err
+	}
+
+	d.SetId(splitId[1])
+	d.Set("project_id", projectId)
+	return []*schema.ResourceData{d}, nil
+}
+
+func harborImmutabilityRulesEndpoint(projectId int) string {
+	return fmt.Sprintf("/projects/%d/immutabletagrules", projectId)
+}
+
+func resourceCloudProjectContainerRegistryImmutabilityRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := harborClientFromResource(ctx, d)
+	endpoint := harborImmutabilityRulesEndpoint(d.Get("project_id").(int))
+	params := harborImmutabilityRuleFromResource(d)
+
+	log.Printf("[DEBUG] Will create immutability rule: %+v", params)
+	id, err := c.Post(endpoint, params)
+	if err != nil {
+		return diag.Errorf("calling Post %s with params %+v:\n\t %s", endpoint, params, err)
+	}
+
+	d.SetId(strconv.FormatInt(id, 10))
+	return resourceCloudProjectContainerRegistryImmutabilityRuleRead(ctx, d, meta)
+}
+
+func resourceCloudProjectContainerRegistryImmutabilityRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := harborClientFromResource(ctx, d)
+	endpoint := harborImmutabilityRulesEndpoint(d.Get("project_id").(int))
+
+	// there is no endpoint to get a single rule
+	var res []HarborTagRule
+	log.Printf("[DEBUG] Will read immutability rule %s", d.Id())
+	if err := c.Get(endpoint, &res); err != nil {
+		if isHarborNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("calling Get %s:\n\t %s", endpoint, err)
+	}
+
+	for _, rule := range res {
+		if strconv.FormatInt(rule.Id, 10) != d.Id() {
+			continue
+		}
+		for k, v := range rule.selectorsToMap(false) {
+			d.Set(k, v)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] Immutability rule %s not found, removing it from the state", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudProjectContainerRegistryImmutabilityRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := harborClientFromResource(ctx, d)
+	endpoint := harborImmutabilityRulesEndpoint(d.Get("project_id").(int)) + "/" + d.Id()
+
+	params := harborImmutabilityRuleFromResource(d)
+	params.Id, _ = strconv.ParseInt(d.Id(), 10, 64)
+
+	log.Printf("[DEBUG] Will update immutability rule %s: %+v", d.Id(), params)
+	if err := c.Put(endpoint, params); err != nil {
+		return diag.Errorf("calling Put %s with params %+v:\n\t %s", endpoint, params, err)
+	}
+
+	return resourceCloudProjectContainerRegistryImmutabilityRuleRead(ctx, d, meta)
+}
+
+func resourceCloudProjectContainerRegistryImmutabilityRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := harborClientFromResource(ctx, d)
+	endpoint := harborImmutabilityRulesEndpoint(d.Get("project_id").(int)) + "/" + d.Id()
+
+	log.Printf("[DEBUG] Will delete immutability rule %s", d.Id())
+	if err := c.Delete(endpoint); err != nil && !isHarborNotFound(err) {
+		return diag.Errorf("calling Delete %s:\n\t %s", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
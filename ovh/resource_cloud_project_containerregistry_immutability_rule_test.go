@@ -0,0 +1,122 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestCloudProjectContainerRegistryImmutabilityRule_standIn(t *testing.T) {
+	s := newTestHarborServer(t)
+	project := testHarborResourceData(t, s, resourceCloudProjectContainerRegistryProject, map[string]interface{}{"name": "apps"})
+	if diags := resourceCloudProjectContainerRegistryProject().CreateContext(context.Background(), project, nil); diags.HasError() {
+		t.Fatalf("project Create() error = %v", diags)
+	}
+	projectId, _ := strconv.Atoi(project.Id())
+
+	r := resourceCloudProjectContainerRegistryImmutabilityRule
+	d := testHarborResourceData(t, s, r, map[string]interface{}{"project_id": projectId, "tag_pattern": "v*"})
+	if diags := r().CreateContext(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("Create() error = %v", diags)
+	}
+	rules := s.immutables[int64(projectId)]
+	if len(rules) != 1 || rules[0].Action != "immutable" || rules[0].TagSelectors[0].Pattern != "v*" {
+		t.Fatalf("unexpected rules in the registry: %+v", rules)
+	}
+	if got := d.Get("repository_pattern").(string); got != "**" {
+		t.Errorf("repository_pattern = %q, want **", got)
+	}
+
+	id := d.Id()
+	d = testHarborResourceData(t, s, r, map[string]interface{}{"project_id": projectId, "tag_pattern": "release-*", "tag_exclude": true})
+	d.SetId(id)
+	if diags := r().UpdateContext(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("Update() error = %v", diags)
+	}
+	if d.Get("tag_pattern").(string) != "release-*" || !d.Get("tag_exclude").(bool) {
+		t.Errorf("unexpected rule after update: tag_pattern %v, tag_exclude %v", d.Get("tag_pattern"), d.Get("tag_exclude"))
+	}
+
+	t.Setenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_URL", s.URL)
+	t.Setenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_USER", testHarborUser)
+	t.Setenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_PASSWORD", testHarborPassword)
+	imported := r().Data(nil)
+	imported.SetId(fmt.Sprintf("%d/%s", projectId, id))
+	if _, err := resourceCloudProjectContainerRegistryImmutabilityRuleImportState(context.Background(), imported, nil); err != nil {
+		t.Fatalf("ImportState() error = %v", err)
+	}
+	if imported.Id() != id || imported.Get("project_id").(int) != projectId {
+		t.Errorf("unexpected imported rule: id %q, project_id %v", imported.Id(), imported.Get("project_id"))
+	}
+
+	if diags := r().DeleteContext(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("Delete() error = %v", diags)
+	}
+	if diags := r().ReadContext(context.Background(), imported, nil); diags.HasError() || imported.Id() != "" {
+		t.Errorf("expected the rule to be gone, got id %q, error %v", imported.Id(), diags)
+	}
+}
+
+const testAccCloudProjectContainerRegistryImmutabilityRuleConfig = `
+resource "ovh_cloud_project_containerregistry_project" "project" {
+  registry_url      = "%[1]s"
+  registry_user     = "%[2]s"
+  registry_password = "%[3]s"
+  name              = "%[4]s"
+}
+
+resource "ovh_cloud_project_containerregistry_immutability_rule" "rule" {
+  registry_url      = "%[1]s"
+  registry_user     = "%[2]s"
+  registry_password = "%[3]s"
+  project_id        = ovh_cloud_project_containerregistry_project.project.id
+  tag_pattern       = "%[5]s"
+}
+`
+
+func TestAccCloudProjectContainerRegistryImmutabilityRule_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	resourceName := "ovh_cloud_project_containerregistry_immutability_rule.rule"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckContainerRegistryHarbor(t)
+			testAccCloudProjectContainerRegistryHarborImportEnv(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudProjectContainerRegistryHarborConfig(testAccCloudProjectContainerRegistryImmutabilityRuleConfig, name, "v*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tag_pattern", "v*"),
+					resource.TestCheckResourceAttr(resourceName, "repository_pattern", "**"),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+				),
+			},
+			{
+				Config: testAccCloudProjectContainerRegistryHarborConfig(testAccCloudProjectContainerRegistryImmutabilityRuleConfig, name, "release-*"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(resourceName, "tag_pattern", "release-*"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rule := s.RootModule().Resources[resourceName]
+					return testAccCloudProjectContainerRegistryProjectIdFunc("ovh_cloud_project_containerregistry_project.project", rule.Primary.ID)(s)
+				},
+			},
+		},
+	})
+}
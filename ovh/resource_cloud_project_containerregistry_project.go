@@ -0,0 +1,182 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudProjectContainerRegistryProject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudProjectContainerRegistryProjectCreate,
+		ReadContext:   resourceCloudProjectContainerRegistryProjectRead,
+		UpdateContext: resourceCloudProjectContainerRegistryProjectUpdate,
+		DeleteContext: resourceCloudProjectContainerRegistryProjectDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudProjectContainerRegistryProjectImportState,
+		},
+
+		Schema: harborResourceSchema(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the registry project",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"public": {
+				Type:        schema.TypeBool,
+				Description: "Allow anonymous users to pull the images of the project",
+				Optional:    true,
+				Default:     false,
+			},
+			"storage_limit": {
+				Type:        schema.TypeInt,
+				Description: "Storage quota of the project in bytes, -1 for unlimited",
+				Optional:    true,
+				Default:     -1,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if value := v.(int); value < -1 || value == 0 {
+						errors = append(errors, fmt.Errorf("%q must be -1 (unlimited) or a positive number of bytes, got %d", k, value))
+					}
+					return
+				},
+			},
+
+			// Computed
+			"storage_used": {
+				Type:        schema.TypeInt,
+				Description: "Storage used by the project in bytes",
+				Computed:    true,
+			},
+			"repo_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of repositories in the project",
+				Computed:    true,
+			},
+		}),
+	}
+}
+
+func resourceCloudProjectContainerRegistryProjectImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := harborImportRegistry(d); err != nil {
+		return nil, err
+	}
+
+	// the project can be imported by name
+	if _, err := strconv.ParseInt(d.Id(), 10, 64); err != nil {
+		c := harborClientFromResource(ctx, d)
+		endpoint := "/projects/" + url.PathEscape(d.Id())
+		res := &HarborProject{}
+		if err := c.Get(endpoint, res); err != nil {
+			return nil, fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+		}
+		d.SetId(strconv.FormatInt(res.ProjectId, 10))
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceCloudProjectContainerRegistryProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := harborClientFromResource(ctx, d)
+	params := (&HarborProjectCreateOpts{}).FromResource(d)
+
+	log.Printf("[DEBUG] Will create registry project: %+v", params)
+	id, err := c.Post("/projects", params)
+	if err != nil {
+		return diag.Errorf("calling Post /projects with params %+v:\n\t %s", params, err)
+	}
+
+	d.SetId(strconv.FormatInt(id, 10))
+	return resourceCloudProjectContainerRegistryProjectRead(ctx, d, meta)
+}
+
+func resourceCloudProjectContainerRegistryProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := harborClientFromResource(ctx, d)
+
+	endpoint := "/projects/" + url.PathEscape(d.Id())
+	res := &HarborProject{}
+
+	log.Printf("[DEBUG] Will read registry project %s", d.Id())
+	if err := c.Get(endpoint, res); err != nil {
+		if isHarborNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("calling Get %s:\n\t %s", endpoint, err)
+	}
+
+	for k, v := range res.ToMap() {
+		d.Set(k, v)
+	}
+
+	quota, err := getHarborProjectQuota(c, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("storage_limit", quota.Hard["storage"])
+	d.Set("storage_used", quota.Used["storage"])
+
+	return nil
+}
+
+func resourceCloudProjectContainerRegistryProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := harborClientFromResource(ctx, d)
+
+	if d.HasChange("public") {
+		endpoint := "/projects/" + url.PathEscape(d.Id())
+		params := &HarborProjectUpdateOpts{}
+		params.Metadata.Public = strconv.FormatBool(d.Get("public").(bool))
+
+		log.Printf("[DEBUG] Will update registry project %s: %+v", d.Id(), params)
+		if err := c.Put(endpoint, params); err != nil {
+			return diag.Errorf("calling Put %s with params %+v:\n\t %s", endpoint, params, err)
+		}
+	}
+
+	if d.HasChange("storage_limit") {
+		quota, err := getHarborProjectQuota(c, d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		endpoint := fmt.Sprintf("/quotas/%d", quota.Id)
+		params := &HarborQuotaUpdateOpts{Hard: map[string]int64{"storage": int64(d.Get("storage_limit").(int))}}
+
+		log.Printf("[DEBUG] Will update quota of registry project %s: %+v", d.Id(), params)
+		if err := c.Put(endpoint, params); err != nil {
+			return diag.Errorf("calling Put %s with params %+v:\n\t %s", endpoint, params, err)
+		}
+	}
+
+	return resourceCloudProjectContainerRegistryProjectRead(ctx, d, meta)
+}
+
+func resourceCloudProjectContainerRegistryProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := harborClientFromResource(ctx, d)
+	endpoint := "/projects/" + url.PathEscape(d.Id())
+
+	log.Printf("[DEBUG] Will delete registry project %s", d.Id())
+	if err := c.Delete(endpoint); err != nil && !isHarborNotFound(err) {
+		return diag.Errorf("calling Delete %s:\n\t %s", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func getHarborProjectQuota(c *harborClient, projectId string) (*HarborQuota, error) {
+	endpoint := "/quotas?reference=project&reference_id=" + url.QueryEscape(projectId)
+	var res []HarborQuota
+	if err := c.Get(endpoint, &res); err != nil {
+		return nil, fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("no quota found for registry project %s", projectId)
+	}
+	return &res[0], nil
+}
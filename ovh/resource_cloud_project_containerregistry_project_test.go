@@ -0,0 +1,144 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestCloudProjectContainerRegistryProject_standIn(t *testing.T) {
+	s := newTestHarborServer(t)
+	r := resourceCloudProjectContainerRegistryProject
+
+	d := testHarborResourceData(t, s, r, map[string]interface{}{"name": "apps"})
+	if diags := r().CreateContext(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("Create() error = %v", diags)
+	}
+	if d.Id() == "" || d.Get("public").(bool) || d.Get("storage_limit").(int) != -1 {
+		t.Errorf("unexpected project after create: id %q, public %v, storage_limit %v", d.Id(), d.Get("public"), d.Get("storage_limit"))
+	}
+
+	if diags := r().CreateContext(context.Background(), testHarborResourceData(t, s, r, map[string]interface{}{"name": "apps"}), nil); !diags.HasError() {
+		t.Errorf("expected an error creating a project with the same name")
+	}
+
+	id := d.Id()
+	d = testHarborResourceData(t, s, r, map[string]interface{}{"name": "apps", "public": true, "storage_limit": 1 << 30})
+	d.SetId(id)
+	if diags := r().UpdateContext(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("Update() error = %v", diags)
+	}
+	if !d.Get("public").(bool) || d.Get("storage_limit").(int) != 1<<30 {
+		t.Errorf("unexpected project after update: public %v, storage_limit %v", d.Get("public"), d.Get("storage_limit"))
+	}
+
+	// import by name
+	t.Setenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_URL", s.URL)
+	t.Setenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_USER", testHarborUser)
+	t.Setenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_PASSWORD", testHarborPassword)
+	imported := r().Data(nil)
+	imported.SetId("apps")
+	if _, err := resourceCloudProjectContainerRegistryProjectImportState(context.Background(), imported, nil); err != nil {
+		t.Fatalf("ImportState() error = %v", err)
+	}
+	if imported.Id() != id {
+		t.Errorf("imported id = %q, want %q", imported.Id(), id)
+	}
+
+	if diags := r().DeleteContext(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("Delete() error = %v", diags)
+	}
+	if diags := r().ReadContext(context.Background(), imported, nil); diags.HasError() || imported.Id() != "" {
+		t.Errorf("expected the project to be gone, got id %q, error %v", imported.Id(), diags)
+	}
+}
+
+const testAccCloudProjectContainerRegistryProjectConfig = `
+resource "ovh_cloud_project_containerregistry_project" "project" {
+  registry_url      = "%s"
+  registry_user     = "%s"
+  registry_password = "%s"
+  name              = "%s"
+  public            = %t
+  storage_limit     = %d
+}
+`
+
+func testAccCloudProjectContainerRegistryHarborConfig(config string, args ...interface{}) string {
+	return fmt.Sprintf(config, append([]interface{}{
+		os.Getenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_URL_TEST"),
+		os.Getenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_USER_TEST"),
+		os.Getenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_PASSWORD_TEST"),
+	}, args...)...)
+}
+
+// testAccCloudProjectContainerRegistryHarborImportEnv sets the registry credentials used to import
+func testAccCloudProjectContainerRegistryHarborImportEnv(t *testing.T) {
+	t.Setenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_URL", os.Getenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_URL_TEST"))
+	t.Setenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_USER", os.Getenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_USER_TEST"))
+	t.Setenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_PASSWORD", os.Getenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_PASSWORD_TEST"))
+}
+
+// testAccCloudProjectContainerRegistryProjectIdFunc returns the id of a registry project,
+// used to import the objects of the project
+func testAccCloudProjectContainerRegistryProjectIdFunc(projectResourceName string, suffix ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		project, ok := s.RootModule().Resources[projectResourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found", projectResourceName)
+		}
+		id := project.Primary.ID
+		for _, attr := range suffix {
+			id += "/" + attr
+		}
+		return id, nil
+	}
+}
+
+func TestAccCloudProjectContainerRegistryProject_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	resourceName := "ovh_cloud_project_containerregistry_project.project"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckContainerRegistryHarbor(t)
+			testAccCloudProjectContainerRegistryHarborImportEnv(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudProjectContainerRegistryHarborConfig(testAccCloudProjectContainerRegistryProjectConfig, name, false, -1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "public", "false"),
+					resource.TestCheckResourceAttr(resourceName, "storage_limit", "-1"),
+					resource.TestCheckResourceAttr(resourceName, "repo_count", "0"),
+				),
+			},
+			{
+				Config: testAccCloudProjectContainerRegistryHarborConfig(testAccCloudProjectContainerRegistryProjectConfig, name, true, 1<<30),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "public", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage_limit", fmt.Sprint(1<<30)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
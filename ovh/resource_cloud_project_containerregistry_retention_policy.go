@@ -0,0 +1,206 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceCloudProjectContainerRegistryRetentionPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudProjectContainerRegistryRetentionPolicyCreate,
+		ReadContext:   resourceCloudProjectContainerRegistryRetentionPolicyRead,
+		UpdateContext: resourceCloudProjectContainerRegistryRetentionPolicyUpdate,
+		DeleteContext: resourceCloudProjectContainerRegistryRetentionPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudProjectContainerRegistryRetentionPolicyImportState,
+		},
+
+		Schema: harborResourceSchema(map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeInt,
+				Description: "ID of the registry project",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"schedule": {
+				Type:        schema.TypeString,
+				Description: "Cron schedule of the policy with seconds, e.g. 0 0 0 * * *, empty to only run it manually",
+				Optional:    true,
+				Default:     "",
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Description: "Rules of the policy, an artifact matching any of them is retained",
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    15,
+				Elem: &schema.Resource{
+					Schema: harborTagRuleSchema(map[string]*schema.Schema{
+						"template": {
+							Type:        schema.TypeString,
+							Description: "Kind of retention: latestPushedK, latestPulledN, nDaysSinceLastPush, nDaysSinceLastPull or always",
+							Required:    true,
+							ValidateFunc: helpers.ValidateEnum([]string{
+								"latestPushedK",
+								"latestPulledN",
+								"nDaysSinceLastPush",
+								"nDaysSinceLastPull",
+								"always",
+							}),
+						},
+						"value": {
+							Type:        schema.TypeInt,
+							Description: "Number of artifacts or days of the template, ignored by always",
+							Optional:    true,
+							Default:     0,
+						},
+						"untagged_artifacts": {
+							Type:        schema.TypeBool,
+							Description: "Apply the rule to the untagged artifacts too",
+							Optional:    true,
+							Default:     false,
+						},
+					}),
+				},
+			},
+		}),
+	}
+}
+
+// harborTagRuleSchema adds the repository and tag selectors to the schema of a tag rule,
+// they are shared by the retention policies and the immutability rules
+func harborTagRuleSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["disabled"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Disable the rule",
+		Optional:    true,
+		Default:     false,
+	}
+	s["repository_pattern"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Doublestar pattern of the repositories, e.g. ** or app/{api,web}",
+		Optional:    true,
+		Default:     "**",
+	}
+	s["repository_exclude"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Apply the rule to the repositories not matching repository_pattern",
+		Optional:    true,
+		Default:     false,
+	}
+	s["tag_pattern"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Doublestar pattern of the tags, e.g. ** or v*",
+		Optional:    true,
+		Default:     "**",
+	}
+	s["tag_exclude"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Apply the rule to the tags not matching tag_pattern",
+		Optional:    true,
+		Default:     false,
+	}
+	return s
+}
+
+func resourceCloudProjectContainerRegistryRetentionPolicyImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := harborImportRegistry(d); err != nil {
+		return nil, err
+	}
+
+	// the policy is imported with the id of its project
+	c := harborClientFromResource(ctx, d)
+	endpoint := "/projects/" + url.PathEscape(d.Id())
+	project := &HarborProject{}
+	if err := c.Get(endpoint, project); err != nil {
+		return nil, fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+	if project.RetentionId() == 0 {
+		return nil, fmt.Errorf("registry project %s has no retention policy", d.Id())
+	}
+
+	d.SetId(strconv.FormatInt(project.RetentionId(), 10))
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceCloudProjectContainerRegistryRetentionPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := harborClientFromResource(ctx, d)
+	projectId := d.Get("project_id").(int)
+
+	// a project has at most one retention policy
+	endpoint := fmt.Sprintf("/projects/%d", projectId)
+	project := &HarborProject{}
+	if err := c.Get(endpoint, project); err != nil {
+		return diag.Errorf("calling Get %s:\n\t %s", endpoint, err)
+	}
+	if id := project.RetentionId(); id != 0 {
+		return diag.Errorf("registry project %d already has the retention policy %d, import it instead", projectId, id)
+	}
+
+	params := (&HarborRetentionPolicy{}).FromResource(d)
+
+	log.Printf("[DEBUG] Will create retention policy of registry project %d: %+v", projectId, params)
+	id, err := c.Post("/retentions", params)
+	if err != nil {
+		return diag.Errorf("calling Post /retentions with params %+v:\n\t %s", params, err)
+	}
+
+	d.SetId(strconv.FormatInt(id, 10))
+	return resourceCloudProjectContainerRegistryRetentionPolicyRead(ctx, d, meta)
+}
+
+func resourceCloudProjectContainerRegistryRetentionPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := harborClientFromResource(ctx, d)
+	endpoint := "/retentions/" + url.PathEscape(d.Id())
+	res := &HarborRetentionPolicy{}
+
+	log.Printf("[DEBUG] Will read retention policy %s", d.Id())
+	if err := c.Get(endpoint, res); err != nil {
+		if isHarborNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("calling Get %s:\n\t %s", endpoint, err)
+	}
+
+	for k, v := range res.ToMap() {
+		d.Set(k, v)
+	}
+
+	return nil
+}
+
+func resourceCloudProjectContainerRegistryRetentionPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := harborClientFromResource(ctx, d)
+	endpoint := "/retentions/" + url.PathEscape(d.Id())
+
+	params := (&HarborRetentionPolicy{}).FromResource(d)
+	params.Id, _ = strconv.ParseInt(d.Id(), 10, 64)
+
+	log.Printf("[DEBUG] Will update retention policy %s: %+v", d.Id(), params)
+	if err := c.Put(endpoint, params); err != nil {
+		return diag.Errorf("calling Put %s with params %+v:\n\t %s", endpoint, params, err)
+	}
+
+	return resourceCloudProjectContainerRegistryRetentionPolicyRead(ctx, d, meta)
+}
+
+func resourceCloudProjectContainerRegistryRetentionPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := harborClientFromResource(ctx, d)
+	endpoint := "/retentions/" + url.PathEscape(d.Id())
+
+	log.Printf("[DEBUG] Will delete retention policy %s", d.Id())
+	if err := c.Delete(endpoint); err != nil && !isHarborNotFound(err) {
+		return diag.Errorf("calling Delete %s:\n\t %s", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,156 @@
+package ovh
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestCloudProjectContainerRegistryRetentionPolicy_standIn(t *testing.T) {
+	s := newTestHarborServer(t)
+	project := testHarborResourceData(t, s, resourceCloudProjectContainerRegistryProject, map[string]interface{}{"name": "apps"})
+	if diags := resourceCloudProjectContainerRegistryProject().CreateContext(context.Background(), project, nil); diags.HasError() {
+		t.Fatalf("project Create() error = %v", diags)
+	}
+	projectId, _ := strconv.Atoi(project.Id())
+
+	r := resourceCloudProjectContainerRegistryRetentionPolicy
+	raw := func(value int) map[string]interface{} {
+		return map[string]interface{}{
+			"project_id": projectId,
+			"schedule":   "0 0 0 * * *",
+			"rule": []interface{}{
+				map[string]interface{}{"template": "latestPushedK", "value": value, "tag_pattern": "v*"},
+				map[string]interface{}{"template": "always", "repository_pattern": "cache/**", "repository_exclude": true, "untagged_artifacts": true},
+			},
+		}
+	}
+
+	d := testHarborResourceData(t, s, r, raw(10))
+	if diags := r().CreateContext(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("Create() error = %v", diags)
+	}
+	policyId, _ := strconv.ParseInt(d.Id(), 10, 64)
+	policy := s.retentions[policyId]
+	if got := policy.Rules[1].ScopeSelectors["repository"][0].Decoration; got != harborRepositoryExclude {
+		t.Errorf("repository decoration = %q, want %q", got, harborRepositoryExclude)
+	}
+	if got := policy.Rules[1].TagSelectors[0].Extras; got != `{"untagged":true}` {
+		t.Errorf("tag extras = %q, want untagged", got)
+	}
+	if _, ok := policy.Rules[1].Params["always"]; ok {
+		t.Errorf("the always template must not have params, got %v", policy.Rules[1].Params)
+	}
+	if got := d.Get("rule.0.value").(int); got != 10 {
+		t.Errorf("rule.0.value = %d, want 10", got)
+	}
+	if !d.Get("rule.1.untagged_artifacts").(bool) || !d.Get("rule.1.repository_exclude").(bool) {
+		t.Errorf("unexpected rule.1 after read: %v", d.Get("rule.1"))
+	}
+
+	if diags := r().CreateContext(context.Background(), testHarborResourceData(t, s, r, raw(10)), nil); !diags.HasError() {
+		t.Errorf("expected an error creating a second policy on the project")
+	}
+
+	id := d.Id()
+	d = testHarborResourceData(t, s, r, raw(5))
+	d.SetId(id)
+	if diags := r().UpdateContext(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("Update() error = %v", diags)
+	}
+	if got := d.Get("rule.0.value").(int); got != 5 {
+		t.Errorf("rule.0.value = %d, want 5", got)
+	}
+
+	// the policy is imported with the id of its project
+	t.Setenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_URL", s.URL)
+	t.Setenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_USER", testHarborUser)
+	t.Setenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_PASSWORD", testHarborPassword)
+	imported := r().Data(nil)
+	imported.SetId(project.Id())
+	if _, err := resourceCloudProjectContainerRegistryRetentionPolicyImportState(context.Background(), imported, nil); err != nil {
+		t.Fatalf("ImportState() error = %v", err)
+	}
+	if imported.Id() != id {
+		t.Errorf("imported id = %q, want %q", imported.Id(), id)
+	}
+
+	if diags := r().DeleteContext(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("Delete() error = %v", diags)
+	}
+	if diags := r().ReadContext(context.Background(), imported, nil); diags.HasError() || imported.Id() != "" {
+		t.Errorf("expected the policy to be gone, got id %q, error %v", imported.Id(), diags)
+	}
+}
+
+const testAccCloudProjectContainerRegistryRetentionPolicyConfig = `
+resource "ovh_cloud_project_containerregistry_project" "project" {
+  registry_url      = "%[1]s"
+  registry_user     = "%[2]s"
+  registry_password = "%[3]s"
+  name              = "%[4]s"
+}
+
+resource "ovh_cloud_project_containerregistry_retention_policy" "policy" {
+  registry_url      = "%[1]s"
+  registry_user     = "%[2]s"
+  registry_password = "%[3]s"
+  project_id        = ovh_cloud_project_containerregistry_project.project.id
+  schedule          = "0 0 0 * * *"
+
+  rule {
+    template    = "latestPushedK"
+    value       = %[5]d
+    tag_pattern = "v*"
+  }
+
+  rule {
+    template           = "nDaysSinceLastPull"
+    value              = 30
+    untagged_artifacts = true
+  }
+}
+`
+
+func TestAccCloudProjectContainerRegistryRetentionPolicy_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	resourceName := "ovh_cloud_project_containerregistry_retention_policy.policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckContainerRegistryHarbor(t)
+			testAccCloudProjectContainerRegistryHarborImportEnv(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudProjectContainerRegistryHarborConfig(testAccCloudProjectContainerRegistryRetentionPolicyConfig, name, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", "ovh_cloud_project_containerregistry_project.project", "id"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.value", "10"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.untagged_artifacts", "true"),
+				),
+			},
+			{
+				Config: testAccCloudProjectContainerRegistryHarborConfig(testAccCloudProjectContainerRegistryRetentionPolicyConfig, name, 5),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(resourceName, "rule.0.value", "5"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccCloudProjectContainerRegistryProjectIdFunc("ovh_cloud_project_containerregistry_project.project"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
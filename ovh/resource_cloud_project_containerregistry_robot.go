@@ -0,0 +1,202 @@
+package ovh
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceCloudProjectContainerRegistryRobot() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudProjectContainerRegistryRobotCreate,
+		ReadContext:   resourceCloudProjectContainerRegistryRobotRead,
+		UpdateContext: resourceCloudProjectContainerRegistryRobotUpdate,
+		DeleteContext: resourceCloudProjectContainerRegistryRobotDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if err := harborImportRegistry(d); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		CustomizeDiff: resourceCloudProjectContainerRegistryRobotCustomizeDiff,
+
+		Schema: harborResourceSchema(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the robot account, Harbor prefixes it with robot$",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of the robot account",
+				Optional:    true,
+			},
+			"level": {
+				Type:         schema.TypeString,
+				Description:  "Level of the robot account: project for a single project, system for several ones",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "project",
+				ValidateFunc: helpers.ValidateEnum([]string{"project", "system"}),
+			},
+			"duration": {
+				Type:        schema.TypeInt,
+				Description: "Validity of the robot account in days from its creation, -1 to never expire",
+				Optional:    true,
+				Default:     -1,
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Description: "Disable the robot account",
+				Optional:    true,
+				Default:     false,
+			},
+			"permission": {
+				Type:        schema.TypeList,
+				Description: "Permissions of the robot account on the registry projects",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"namespace": {
+							Type:        schema.TypeString,
+							Description: "Name of the registry project, * for all the projects of a system robot",
+							Required:    true,
+						},
+						"access": {
+							Type:        schema.TypeSet,
+							Description: "Actions allowed on the resources of the project",
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource": {
+										Type:        schema.TypeString,
+										Description: "Resource type, e.g. repository, artifact or tag",
+										Required:    true,
+									},
+									"action": {
+										Type:        schema.TypeString,
+										Description: "Action allowed on the resource, e.g. pull, push, delete or list",
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			// Computed
+			"robot_name": {
+				Type:        schema.TypeString,
+				Description: "Full name of the robot account, to be used as login",
+				Computed:    true,
+			},
+			"secret": {
+				Type:        schema.TypeString,
+				Description: "Secret of the robot account, only known when it is created",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expires_at": {
+				Type:        schema.TypeInt,
+				Description: "Expiration date of the robot account as a UNIX timestamp, -1 if it never expires",
+				Computed:    true,
+			},
+		}),
+	}
+}
+
+func resourceCloudProjectContainerRegistryRobotCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("permission") {
+		return nil
+	}
+
+	namespaces := make([]string, 0)
+	for _, p := range d.Get("permission").([]interface{}) {
+		namespaces = append(namespaces, p.(map[string]interface{})["namespace"].(string))
+	}
+	return validateHarborRobotPermissions(d.Get("level").(string), namespaces)
+}
+
+func resourceCloudProjectContainerRegistryRobotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := harborClientFromResource(ctx, d)
+	params := (&HarborRobot{}).FromResource(d)
+	res := &HarborRobotCreateResponse{}
+
+	log.Printf("[DEBUG] Will create registry robot account: %+v", params)
+	if _, err := c.call(http.MethodPost, "/robots", params, res); err != nil {
+		return diag.Errorf("calling Post /robots with params %+v:\n\t %s", params, err)
+	}
+
+	d.SetId(strconv.FormatInt(res.Id, 10))
+	d.Set("secret", res.Secret)
+
+	return resourceCloudProjectContainerRegistryRobotRead(ctx, d, meta)
+}
+
+func resourceCloudProjectContainerRegistryRobotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := harborClientFromResource(ctx, d)
+	endpoint := "/robots/" + url.PathEscape(d.Id())
+	res := &HarborRobot{}
+
+	log.Printf("[DEBUG] Will read registry robot account %s", d.Id())
+	if err := c.Get(endpoint, res); err != nil {
+		if isHarborNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("calling Get %s:\n\t %s", endpoint, err)
+	}
+
+	for k, v := range res.ToMap() {
+		d.Set(k, v)
+	}
+
+	// the name isn't known when importing
+	if d.Get("name").(string) == "" {
+		d.Set("name", harborRobotShortName(res.Name))
+	}
+
+	return nil
+}
+
+func resourceCloudProjectContainerRegistryRobotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := harborClientFromResource(ctx, d)
+	endpoint := "/robots/" + url.PathEscape(d.Id())
+
+	params := (&HarborRobot{}).FromResource(d)
+	// Harbor expects the full name of the robot account
+	params.Id, _ = strconv.ParseInt(d.Id(), 10, 64)
+	params.Name = d.Get("robot_name").(string)
+
+	log.Printf("[DEBUG] Will update registry robot account %s: %+v", d.Id(), params)
+	if err := c.Put(endpoint, params); err != nil {
+		return diag.Errorf("calling Put %s with params %+v:\n\t %s", endpoint, params, err)
+	}
+
+	return resourceCloudProjectContainerRegistryRobotRead(ctx, d, meta)
+}
+
+func resourceCloudProjectContainerRegistryRobotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := harborClientFromResource(ctx, d)
+	endpoint := "/robots/" + url.PathEscape(d.Id())
+
+	log.Printf("[DEBUG] Will delete registry robot account %s", d.Id())
+	if err := c.Delete(endpoint); err != nil && !isHarborNotFound(err) {
+		return diag.Errorf("calling Delete %s:\n\t %s", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
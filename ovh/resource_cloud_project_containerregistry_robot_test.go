@@ -0,0 +1,172 @@
+package ovh
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func Test_harborRobotShortName(t *testing.T) {
+	tests := map[string]string{
+		"robot$apps+ci": "ci",
+		"robot$ci":      "ci",
+		"ci":            "ci",
+	}
+	for fullName, want := range tests {
+		if got := harborRobotShortName(fullName); got != want {
+			t.Errorf("harborRobotShortName(%q) = %q, want %q", fullName, got, want)
+		}
+	}
+}
+
+func Test_validateHarborRobotPermissions(t *testing.T) {
+	if err := validateHarborRobotPermissions("project", []string{"apps"}); err != nil {
+		t.Errorf("unexpected error for a project robot: %v", err)
+	}
+	if err := validateHarborRobotPermissions("project", []string{"apps", "tools"}); err == nil {
+		t.Errorf("expected an error for a project robot on two projects")
+	}
+	if err := validateHarborRobotPermissions("project", []string{"*"}); err == nil {
+		t.Errorf("expected an error for a project robot on all the projects")
+	}
+	if err := validateHarborRobotPermissions("system", []string{"apps", "*"}); err != nil {
+		t.Errorf("unexpected error for a system robot: %v", err)
+	}
+}
+
+func TestCloudProjectContainerRegistryRobot_standIn(t *testing.T) {
+	s := newTestHarborServer(t)
+	r := resourceCloudProjectContainerRegistryRobot
+
+	raw := func(description string, actions ...string) map[string]interface{} {
+		access := make([]interface{}, 0, len(actions))
+		for _, action := range actions {
+			access = append(access, map[string]interface{}{"resource": "repository", "action": action})
+		}
+		return map[string]interface{}{
+			"name":        "ci",
+			"description": description,
+			"permission": []interface{}{
+				map[string]interface{}{"namespace": "apps", "access": access},
+			},
+		}
+	}
+
+	d := testHarborResourceData(t, s, r, raw("pull only", "pull"))
+	if diags := r().CreateContext(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("Create() error = %v", diags)
+	}
+	if got := d.Get("robot_name").(string); got != "robot$apps+ci" {
+		t.Errorf("robot_name = %q, want robot$apps+ci", got)
+	}
+	if got := d.Get("secret").(string); got != "robot-secret" {
+		t.Errorf("secret = %q, want robot-secret", got)
+	}
+
+	id := d.Id()
+	d = testHarborResourceData(t, s, r, raw("pull and push", "pull", "push"))
+	d.SetId(id)
+	d.Set("robot_name", "robot$apps+ci")
+	if diags := r().UpdateContext(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("Update() error = %v", diags)
+	}
+	if got := d.Get("description").(string); got != "pull and push" {
+		t.Errorf("description = %q, want pull and push", got)
+	}
+	if got := d.Get("permission.0.access.#").(int); got != 2 {
+		t.Errorf("permission.0.access.# = %d, want 2", got)
+	}
+
+	// the name is deduced from the full name when importing
+	imported := r().Data(nil)
+	imported.SetId(id)
+	imported.Set("registry_url", s.URL)
+	imported.Set("registry_user", testHarborUser)
+	imported.Set("registry_password", testHarborPassword)
+	if diags := r().ReadContext(context.Background(), imported, nil); diags.HasError() {
+		t.Fatalf("Read() error = %v", diags)
+	}
+	if got := imported.Get("name").(string); got != "ci" {
+		t.Errorf("imported name = %q, want ci", got)
+	}
+
+	if diags := r().DeleteContext(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("Delete() error = %v", diags)
+	}
+	if diags := r().ReadContext(context.Background(), imported, nil); diags.HasError() || imported.Id() != "" {
+		t.Errorf("expected the robot to be gone, got id %q, error %v", imported.Id(), diags)
+	}
+}
+
+const testAccCloudProjectContainerRegistryRobotConfig = `
+resource "ovh_cloud_project_containerregistry_project" "project" {
+  registry_url      = "%[1]s"
+  registry_user     = "%[2]s"
+  registry_password = "%[3]s"
+  name              = "%[4]s"
+}
+
+resource "ovh_cloud_project_containerregistry_robot" "robot" {
+  registry_url      = "%[1]s"
+  registry_user     = "%[2]s"
+  registry_password = "%[3]s"
+  name              = "ci"
+  description       = "%[5]s"
+
+  permission {
+    namespace = ovh_cloud_project_containerregistry_project.project.name
+
+    access {
+      resource = "repository"
+      action   = "pull"
+    }
+
+    access {
+      resource = "repository"
+      action   = "push"
+    }
+  }
+}
+`
+
+func TestAccCloudProjectContainerRegistryRobot_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	resourceName := "ovh_cloud_project_containerregistry_robot.robot"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckContainerRegistryHarbor(t)
+			testAccCloudProjectContainerRegistryHarborImportEnv(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudProjectContainerRegistryHarborConfig(testAccCloudProjectContainerRegistryRobotConfig, name, "CI"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "robot_name", "robot$"+name+"+ci"),
+					resource.TestCheckResourceAttr(resourceName, "level", "project"),
+					resource.TestCheckResourceAttr(resourceName, "permission.0.access.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "secret"),
+				),
+			},
+			{
+				Config: testAccCloudProjectContainerRegistryHarborConfig(testAccCloudProjectContainerRegistryRobotConfig, name, "CI pipelines"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(resourceName, "description", "CI pipelines"),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
@@ -0,0 +1,192 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceCloudProjectFloatingIp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudProjectFloatingIpCreate,
+		Read:   resourceCloudProjectFloatingIpRead,
+		Delete: resourceCloudProjectFloatingIpDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudProjectFloatingIpImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+				Description: "Service name of the resource representing the ID of the cloud project.",
+			},
+			"region_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Region of the floating IP",
+			},
+			"network_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "ID of the public network the floating IP is allocated from",
+			},
+
+			// computed
+			"ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IP address of the floating IP",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the floating IP",
+			},
+			"associated_entity": cloudProjectFloatingIpAssociatedEntitySchema(),
+		},
+	}
+}
+
+// cloudProjectFloatingIpAssociatedEntitySchema is the entity a floating IP is associated with,
+// shared by the resource and the data sources
+func cloudProjectFloatingIpAssociatedEntitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Entity the floating IP is associated with",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "ID of the entity",
+				},
+				"ip": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Private IP of the entity",
+				},
+				"gateway_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "ID of the gateway routing the floating IP",
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Type of the entity",
+				},
+			},
+		},
+	}
+}
+
+func resourceCloudProjectFloatingIpImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("import Id is not service_name/region_name/floating_ip_id formatted")
+	}
+	d.SetId(splitId[2])
+	d.Set("service_name", splitId[0])
+	d.Set("region_name", splitId[1])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceCloudProjectFloatingIpCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	if err := checkCloudProjectRegionNetwork(config.OVHClient, serviceName, regionName); err != nil {
+		return err
+	}
+
+	params := (&CloudProjectFloatingIpCreateOpts{}).FromResource(d)
+	endpoint := cloudProjectFloatingIpEndpoint(serviceName, regionName)
+	res := &CloudProjectFloatingIpResponse{}
+
+	log.Printf("[DEBUG] Will create floating IP in region %s: %+v", regionName, params)
+	if err := config.OVHClient.Post(endpoint, params, res); err != nil {
+		return fmt.Errorf("calling Post %s with params %+v:\n\t %w", endpoint, params, err)
+	}
+	d.SetId(res.Id)
+
+	return resourceCloudProjectFloatingIpRead(d, meta)
+}
+
+func resourceCloudProjectFloatingIpRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	endpoint := cloudProjectFloatingIpEndpoint(serviceName, regionName, d.Id())
+	res := &CloudProjectFloatingIpResponse{}
+
+	log.Printf("[DEBUG] Will read floating IP %s", d.Id())
+	if err := config.OVHClient.Get(endpoint, res); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	for k, v := range res.ToMap() {
+		d.Set(k, v)
+	}
+
+	log.Printf("[DEBUG] Read floating IP: %+v", res)
+	return nil
+}
+
+func resourceCloudProjectFloatingIpDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	endpoint := cloudProjectFloatingIpEndpoint(serviceName, regionName, d.Id())
+
+	log.Printf("[DEBUG] Will delete floating IP %s", d.Id())
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// cloudProjectFloatingIpEndpoint returns the endpoint of the floating IPs of a region,
+// or of one of them
+func cloudProjectFloatingIpEndpoint(serviceName, regionName string, path ...string) string {
+	endpoint := fmt.Sprintf("/cloud/project/%s/region/%s/floatingip",
+		url.PathEscape(serviceName),
+		url.PathEscape(regionName))
+	for _, p := range path {
+		endpoint += "/" + url.PathEscape(p)
+	}
+	return endpoint
+}
+
+// checkCloudProjectRegionNetwork checks the region is enabled in the project
+// and has its network service up
+func checkCloudProjectRegionNetwork(c *ovh.Client, serviceName, regionName string) error {
+	region, err := getCloudProjectRegion(serviceName, regionName, c)
+	if err != nil {
+		return err
+	}
+	if !region.HasServiceUp("network") {
+		return fmt.Errorf("network service of region %s is not up in project %s", regionName, serviceName)
+	}
+	return nil
+}
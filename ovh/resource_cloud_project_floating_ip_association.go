@@ -0,0 +1,311 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceCloudProjectFloatingIpAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudProjectFloatingIpAssociationCreate,
+		Read:   resourceCloudProjectFloatingIpAssociationRead,
+		Delete: resourceCloudProjectFloatingIpAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudProjectFloatingIpAssociationImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+				Description: "Service name of the resource representing the ID of the cloud project.",
+			},
+			"region_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Region of the floating IP and of the entity it is associated with",
+			},
+			"floating_ip_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the floating IP",
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "ID of the instance the floating IP is associated with",
+				ExactlyOneOf: []string{"instance_id", "loadbalancer_id"},
+			},
+			"loadbalancer_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "ID of the load balancer whose VIP the floating IP is associated with",
+				ExactlyOneOf: []string{"instance_id", "loadbalancer_id"},
+			},
+			"ip": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Private IP the floating IP is associated with, the first private IPv4 of the instance or the VIP of the load balancer by default",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if err := helpers.ValidateIp(v.(string)); err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// computed
+			"floating_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IP address of the floating IP",
+			},
+			"port_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the port of the private IP the floating IP is associated with",
+			},
+		},
+	}
+}
+
+func resourceCloudProjectFloatingIpAssociationImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("import Id is not service_name/region_name/floating_ip_id formatted")
+	}
+	serviceName, regionName, floatingIpId := splitId[0], splitId[1], splitId[2]
+
+	endpoint := cloudProjectFloatingIpEndpoint(serviceName, regionName, floatingIpId)
+	res := &CloudProjectFloatingIpResponse{}
+	if err := config.OVHClient.Get(endpoint, res); err != nil {
+		return nil, fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+	if res.AssociatedEntity == nil {
+		return nil, fmt.Errorf("floating IP %s is not associated", floatingIpId)
+	}
+
+	// the API only returns the port of the association, look for the entity owning its private IP
+	ip := res.AssociatedEntity.Ip
+	switch res.AssociatedEntity.Type {
+	case "loadbalancer":
+		var lbs []CloudProjectLoadbalancerResponse
+		endpoint = cloudProjectLoadbalancingEndpoint(serviceName, regionName, "loadbalancer")
+		if err := config.OVHClient.Get(endpoint, &lbs); err != nil {
+			return nil, fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+		}
+		for _, lb := range lbs {
+			if lb.VipAddress == ip {
+				d.Set("loadbalancer_id", lb.Id)
+			}
+		}
+	case "instance":
+		var instances []CloudProjectInstanceResponse
+		endpoint = fmt.Sprintf("/cloud/project/%s/instance?region=%s", url.PathEscape(serviceName), url.QueryEscape(regionName))
+		if err := config.OVHClient.Get(endpoint, &instances); err != nil {
+			return nil, fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+		}
+		for i := range instances {
+			if _, err := cloudProjectInstancePrivateIp(&instances[i], ip); err == nil {
+				d.Set("instance_id", instances[i].Id)
+			}
+		}
+	}
+	if d.Get("instance_id").(string) == "" && d.Get("loadbalancer_id").(string) == "" {
+		return nil, fmt.Errorf("no instance or load balancer owns the private IP %s of floating IP %s", ip, floatingIpId)
+	}
+
+	d.SetId(floatingIpId)
+	d.Set("service_name", serviceName)
+	d.Set("region_name", regionName)
+	d.Set("floating_ip_id", floatingIpId)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceCloudProjectFloatingIpAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+	floatingIpId := d.Get("floating_ip_id").(string)
+
+	// a floating IP can only be associated with an entity of its own region
+	var endpoint, ip string
+	if instanceId, ok := d.GetOk("instance_id"); ok {
+		instance := &CloudProjectInstanceResponse{}
+		endpoint = fmt.Sprintf("/cloud/project/%s/instance/%s", url.PathEscape(serviceName), url.PathEscape(instanceId.(string)))
+		if err := config.OVHClient.Get(endpoint, instance); err != nil {
+			return fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+		}
+		if instance.Region != regionName {
+			return fmt.Errorf("instance %s is in region %s, not in region %s of the floating IP", instance.Id, instance.Region, regionName)
+		}
+
+		var err error
+		if ip, err = cloudProjectInstancePrivateIp(instance, d.Get("ip").(string)); err != nil {
+			return err
+		}
+		endpoint = fmt.Sprintf("/cloud/project/%s/region/%s/instance/%s/associateFloatingIp",
+			url.PathEscape(serviceName), url.PathEscape(regionName), url.PathEscape(instance.Id))
+	} else {
+		loadbalancerId := d.Get("loadbalancer_id").(string)
+		lb := &CloudProjectLoadbalancerResponse{}
+		endpoint = cloudProjectLoadbalancingEndpoint(serviceName, regionName, "loadbalancer", loadbalancerId)
+		if err := config.OVHClient.Get(endpoint, lb); err != nil {
+			if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+				return fmt.Errorf("load balancer %s not found in region %s of the floating IP", loadbalancerId, regionName)
+			}
+			return fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+		}
+		if given := d.Get("ip").(string); given != "" && given != lb.VipAddress {
+			return fmt.Errorf("ip %s is not the VIP %s of load balancer %s", given, lb.VipAddress, loadbalancerId)
+		}
+
+		ip = lb.VipAddress
+		endpoint = cloudProjectLoadbalancingEndpoint(serviceName, regionName, "loadbalancer", loadbalancerId, "associateFloatingIp")
+	}
+
+	params := &CloudProjectFloatingIpAssociateOpts{FloatingIpId: floatingIpId, Ip: ip}
+
+	log.Printf("[DEBUG] Will associate floating IP %s with %s", floatingIpId, ip)
+	if err := config.OVHClient.Post(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Post %s with params %+v:\n\t %w", endpoint, params, err)
+	}
+	d.SetId(floatingIpId)
+	d.Set("ip", ip)
+
+	res, err := waitForCloudProjectFloatingIpAssociation(config.OVHClient, serviceName, regionName, floatingIpId, ip, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+	d.Set("port_id", res.AssociatedEntity.Id)
+
+	return resourceCloudProjectFloatingIpAssociationRead(d, meta)
+}
+
+func resourceCloudProjectFloatingIpAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	endpoint := cloudProjectFloatingIpEndpoint(serviceName, regionName, d.Id())
+	res := &CloudProjectFloatingIpResponse{}
+
+	log.Printf("[DEBUG] Will read association of floating IP %s", d.Id())
+	if err := config.OVHClient.Get(endpoint, res); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	// the floating IP has been detached or moved to another port outside of terraform
+	portId := d.Get("port_id").(string)
+	if res.AssociatedEntity == nil || portId != "" && res.AssociatedEntity.Id != portId {
+		log.Printf("[WARN] Floating IP %s is no longer associated with port %s, removing it from state", d.Id(), portId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("floating_ip_id", d.Id())
+	d.Set("floating_ip", res.Ip)
+	d.Set("ip", res.AssociatedEntity.Ip)
+	d.Set("port_id", res.AssociatedEntity.Id)
+
+	log.Printf("[DEBUG] Read floating IP association: %+v", res)
+	return nil
+}
+
+func resourceCloudProjectFloatingIpAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	endpoint := cloudProjectFloatingIpEndpoint(serviceName, regionName, d.Id(), "detach")
+
+	log.Printf("[DEBUG] Will detach floating IP %s", d.Id())
+	if err := config.OVHClient.Post(endpoint, nil, nil); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	if _, err := waitForCloudProjectFloatingIpAssociation(config.OVHClient, serviceName, regionName, d.Id(), "", d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// cloudProjectInstancePrivateIp returns the given private IP of the instance after checking it belongs to it,
+// or its first private IPv4
+func cloudProjectInstancePrivateIp(instance *CloudProjectInstanceResponse, ip string) (string, error) {
+	for _, address := range instance.IpAddresses {
+		if address.Type != "private" {
+			continue
+		}
+		if ip == "" && address.Version == 4 || ip == address.Ip {
+			return address.Ip, nil
+		}
+	}
+	if ip != "" {
+		return "", fmt.Errorf("ip %s is not a private IP of instance %s", ip, instance.Id)
+	}
+	return "", fmt.Errorf("instance %s has no private IPv4 to associate the floating IP with", instance.Id)
+}
+
+// waitForCloudProjectFloatingIpAssociation waits for the floating IP to be associated with the private IP,
+// or to be detached when it is empty
+func waitForCloudProjectFloatingIpAssociation(c *ovh.Client, serviceName, regionName, floatingIpId, ip string, timeout time.Duration) (*CloudProjectFloatingIpResponse, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			res := &CloudProjectFloatingIpResponse{}
+			endpoint := cloudProjectFloatingIpEndpoint(serviceName, regionName, floatingIpId)
+			if err := c.Get(endpoint, res); err != nil {
+				return res, "", fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+			}
+
+			associatedIp := ""
+			if res.AssociatedEntity != nil {
+				associatedIp = res.AssociatedEntity.Ip
+			}
+			log.Printf("[DEBUG] Pending floating IP: %s is associated with %q", floatingIpId, associatedIp)
+			if associatedIp != ip {
+				return res, "pending", nil
+			}
+			return res, "done", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	res, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, fmt.Errorf("waiting for floating IP %s to be associated with %q: %s", floatingIpId, ip, err)
+	}
+	return res.(*CloudProjectFloatingIpResponse), nil
+}
@@ -0,0 +1,112 @@
+package ovh
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func Test_cloudProjectInstancePrivateIp(t *testing.T) {
+	instance := &CloudProjectInstanceResponse{
+		Id: "instance-id",
+		IpAddresses: []CloudProjectInstanceIpAddress{
+			{Ip: "51.68.0.10", Type: "public", Version: 4},
+			{Ip: "fd00::12", Type: "private", Version: 6},
+			{Ip: "10.0.0.12", Type: "private", Version: 4},
+			{Ip: "10.1.0.12", Type: "private", Version: 4},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		ip      string
+		want    string
+		wantErr bool
+	}{
+		{name: "first private IPv4", ip: "", want: "10.0.0.12"},
+		{name: "given private IP", ip: "10.1.0.12", want: "10.1.0.12"},
+		{name: "public IP", ip: "51.68.0.10", wantErr: true},
+		{name: "unknown IP", ip: "10.2.0.12", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cloudProjectInstancePrivateIp(instance, tt.ip)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("cloudProjectInstancePrivateIp() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("cloudProjectInstancePrivateIp() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := cloudProjectInstancePrivateIp(&CloudProjectInstanceResponse{Id: "public-only"}, ""); err == nil {
+		t.Error("cloudProjectInstancePrivateIp() expected an error for an instance without private IP")
+	}
+}
+
+var testAccCloudProjectFloatingIpAssociationConfig = `
+resource "ovh_cloud_project_floating_ip" "ip" {
+  service_name = ovh_cloud_project_loadbalancer.lb.service_name
+  region_name  = ovh_cloud_project_loadbalancer.lb.region_name
+}
+
+resource "ovh_cloud_project_floating_ip_association" "association" {
+  service_name    = ovh_cloud_project_floating_ip.ip.service_name
+  region_name     = ovh_cloud_project_floating_ip.ip.region_name
+  floating_ip_id  = ovh_cloud_project_floating_ip.ip.id
+  loadbalancer_id = ovh_cloud_project_loadbalancer.lb.id
+}
+`
+
+func TestAccCloudProjectFloatingIpAssociation_loadbalancer(t *testing.T) {
+	network := newTestAccCloudProjectLoadbalancerNetwork()
+	lbName := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloudLoadbalancing(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: network.config(lbName, testAccCloudProjectFloatingIpAssociationConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ovh_cloud_project_floating_ip_association.association", "ip",
+						"ovh_cloud_project_loadbalancer.lb", "vip_address",
+					),
+					resource.TestCheckResourceAttrPair(
+						"ovh_cloud_project_floating_ip_association.association", "floating_ip",
+						"ovh_cloud_project_floating_ip.ip", "ip",
+					),
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_floating_ip_association.association", "port_id"),
+				),
+			},
+			{
+				ResourceName:      "ovh_cloud_project_floating_ip_association.association",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccCloudProjectFloatingIpAssociationImportId("ovh_cloud_project_floating_ip_association.association"),
+			},
+		},
+	})
+}
+
+func testAccCloudProjectFloatingIpAssociationImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		association, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("%s not found", resourceName)
+		}
+		return fmt.Sprintf(
+			"%s/%s/%s",
+			association.Primary.Attributes["service_name"],
+			association.Primary.Attributes["region_name"],
+			association.Primary.ID,
+		), nil
+	}
+}
@@ -0,0 +1,60 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testAccCloudProjectFloatingIpConfig = `
+resource "ovh_cloud_project_floating_ip" "ip" {
+  service_name = "%s"
+  region_name  = "%s"
+}
+
+data "ovh_cloud_project_floating_ip" "ip" {
+  service_name = ovh_cloud_project_floating_ip.ip.service_name
+  region_name  = ovh_cloud_project_floating_ip.ip.region_name
+  id           = ovh_cloud_project_floating_ip.ip.id
+}
+
+data "ovh_cloud_project_floating_ips" "ips" {
+  service_name = ovh_cloud_project_floating_ip.ip.service_name
+  region_name  = ovh_cloud_project_floating_ip.ip.region_name
+}
+`
+
+func TestAccCloudProjectFloatingIp_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	region := os.Getenv("OVH_CLOUD_PROJECT_REGION_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloudRegion(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudProjectFloatingIpConfig, serviceName, region),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_floating_ip.ip", "ip"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_floating_ip.ip", "network_id"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_floating_ip.ip", "associated_entity.#", "0"),
+					resource.TestCheckResourceAttrPair(
+						"data.ovh_cloud_project_floating_ip.ip", "ip",
+						"ovh_cloud_project_floating_ip.ip", "ip",
+					),
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_project_floating_ips.ips", "floating_ips.0.id"),
+				),
+			},
+			{
+				ResourceName:        "ovh_cloud_project_floating_ip.ip",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: serviceName + "/" + region + "/",
+			},
+		},
+	})
+}
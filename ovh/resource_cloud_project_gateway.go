@@ -1,6 +1,7 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -14,6 +15,9 @@ import (
 	"github.com/ovh/go-ovh/ovh"
 )
 
+// cloudProjectGatewayModels are the gateway models ordered by size
+var cloudProjectGatewayModels = []string{"s", "m", "l"}
+
 func resourceOvhCloudProjectGatewayImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	givenId := d.Id()
 
@@ -38,6 +42,13 @@ func resourceCloudProjectGateway() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceOvhCloudProjectGatewayImportState,
 		},
+		CustomizeDiff: resourceCloudProjectGatewayCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"service_name": {
@@ -52,8 +63,10 @@ func resourceCloudProjectGateway() *schema.Resource {
 				Required: true,
 			},
 			"model": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Model of the gateway, it can be upgraded in place (s, m then l)",
+				ValidateFunc: helpers.ValidateEnum(cloudProjectGatewayModels),
 			},
 			"region": {
 				Type:     schema.TypeString,
@@ -74,6 +87,11 @@ func resourceCloudProjectGateway() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"snat_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Public IP used by the gateway to SNAT the outgoing traffic of the private network",
+			},
 			"external_information": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -173,7 +191,7 @@ func resourceCloudProjectGatewayCreate(d *schema.ResourceData, meta interface{})
 		Pending:    []string{"in-progress"},
 		Target:     []string{"active"},
 		Refresh:    waitForCloudProjectGatewayActive(config.OVHClient, serviceName, r.Id),
-		Timeout:    10 * time.Minute,
+		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -221,6 +239,7 @@ func resourceCloudProjectGatewayRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("name", r.Name)
 	d.Set("model", r.Model)
 	d.Set("status", r.Status)
+	d.Set("snat_ip", r.SnatIp())
 	d.Set("region", region)
 	d.SetId(r.Id)
 	d.Set("service_name", serviceName)
@@ -272,10 +291,21 @@ func resourceCloudProjectGatewayUpdate(d *schema.ResourceData, meta interface{})
 		url.PathEscape(region),
 		url.PathEscape(d.Id()))
 
-	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+	op := &CloudProjectOperationResponse{}
+	if err := config.OVHClient.Put(endpoint, params, op); err != nil {
 		return fmt.Errorf("calling %s with params %s:\n\t %q", endpoint, params, err)
 	}
 
+	// a model upgrade is asynchronous, the gateway is rebuilt by the operation
+	if op.Id != "" {
+		if _, err := waitForCloudProjectOperation(config.OVHClient, serviceName, op.Id, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+	if err := waitForCloudProjectGatewayReady(config.OVHClient, serviceName, region, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Updated Public cloud %s Gateway %s:", serviceName, d.Id())
 
 	return resourceCloudProjectGatewayRead(d, meta)
@@ -304,7 +334,7 @@ func resourceCloudProjectGatewayDelete(d *schema.ResourceData, meta interface{})
 		Pending:    []string{"in-progress"},
 		Target:     []string{"completed"},
 		Refresh:    waitForCloudProjectGatewayDelete(config.OVHClient, serviceName, r.Id),
-		Timeout:    10 * time.Minute,
+		Timeout:    d.Timeout(schema.TimeoutDelete),
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -319,6 +349,66 @@ func resourceCloudProjectGatewayDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func resourceCloudProjectGatewayCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("model") {
+		return nil
+	}
+
+	o, n := d.GetChange("model")
+	if cloudProjectGatewayModelIsDowngrade(o.(string), n.(string)) {
+		return fmt.Errorf("gateway model can only be upgraded (%s), replace the gateway to go from %q to %q",
+			strings.Join(cloudProjectGatewayModels, " -> "), o, n)
+	}
+	return nil
+}
+
+// cloudProjectGatewayModelIsDowngrade tells whether going from model o to model n is a downgrade,
+// models are ordered by size in cloudProjectGatewayModels and, as validated by the schema, lower-case
+func cloudProjectGatewayModelIsDowngrade(o, n string) bool {
+	rank := func(model string) int {
+		for i, m := range cloudProjectGatewayModels {
+			if m == model {
+				return i
+			}
+		}
+		return -1
+	}
+	return rank(n) < rank(o)
+}
+
+// waitForCloudProjectGatewayReady waits for the gateway to be active again after an update,
+// e.g. a model upgrade or an interface change
+func waitForCloudProjectGatewayReady(c *ovh.Client, serviceName, region, gatewayId string, timeout time.Duration) error {
+	endpoint := fmt.Sprintf("/cloud/project/%s/region/%s/gateway/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(region),
+		url.PathEscape(gatewayId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"in-progress", "building", "updating", "pending"},
+		Target:  []string{"active"},
+		Refresh: func() (interface{}, string, error) {
+			r := &CloudProjectGatewayResponse{}
+			if err := c.Get(endpoint, r); err != nil {
+				return r, "", fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+			}
+			log.Printf("[DEBUG] Pending Gateway: %+v", r)
+			if r.Status == "error" {
+				return r, r.Status, fmt.Errorf("gateway %s is in error", gatewayId)
+			}
+			return r, r.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for gateway %s to be active: %s", gatewayId, err)
+	}
+	return nil
+}
+
 // AttachmentStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
 // an Attachment Task.
 func waitForCloudProjectGatewayActive(c *ovh.Client, serviceName, operationId string) resource.StateRefreshFunc {
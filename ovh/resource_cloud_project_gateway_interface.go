@@ -0,0 +1,174 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceCloudProjectGatewayInterface() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudProjectGatewayInterfaceCreate,
+		Read:   resourceCloudProjectGatewayInterfaceRead,
+		Delete: resourceCloudProjectGatewayInterfaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudProjectGatewayInterfaceImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+				Description: "Service name of the resource representing the id of the cloud project.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Region of the gateway",
+			},
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the gateway",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the subnet to attach to the gateway",
+			},
+			"ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IP of the interface in the subnet",
+			},
+			"network_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Network ID of the interface",
+			},
+		},
+	}
+}
+
+func resourceCloudProjectGatewayInterfaceImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 4)
+	if len(splitId) != 4 {
+		return nil, fmt.Errorf("import id is not service_name/region/gateway_id/interface_id formatted")
+	}
+
+	d.SetId(splitId[3])
+	d.Set("service_name", splitId[0])
+	d.Set("region", splitId[1])
+	d.Set("gateway_id", splitId[2])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// cloudProjectGatewayInterfaceEndpoint returns the endpoint of the interfaces of a gateway,
+// or of one of its interfaces
+func cloudProjectGatewayInterfaceEndpoint(serviceName, region, gatewayId string, interfaceId ...string) string {
+	endpoint := fmt.Sprintf("/cloud/project/%s/region/%s/gateway/%s/interface",
+		url.PathEscape(serviceName),
+		url.PathEscape(region),
+		url.PathEscape(gatewayId))
+	for _, id := range interfaceId {
+		endpoint += "/" + url.PathEscape(id)
+	}
+	return endpoint
+}
+
+func resourceCloudProjectGatewayInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	region := d.Get("region").(string)
+	gatewayId := d.Get("gateway_id").(string)
+
+	params := &CloudProjectGatewayInterfaceCreateOpts{
+		SubnetId: d.Get("subnet_id").(string),
+	}
+
+	// the gateway can't be changed while a model upgrade or another interface is in progress
+	if err := waitForCloudProjectGatewayReady(config.OVHClient, serviceName, region, gatewayId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Will create interface on gateway %s: %s", gatewayId, params)
+
+	endpoint := cloudProjectGatewayInterfaceEndpoint(serviceName, region, gatewayId)
+	r := &CloudProjectGatewayInterface{}
+	if err := config.OVHClient.Post(endpoint, params, r); err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %w", endpoint, params, err)
+	}
+
+	d.SetId(r.Id)
+
+	if err := waitForCloudProjectGatewayReady(config.OVHClient, serviceName, region, gatewayId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Created interface %s on gateway %s", r.Id, gatewayId)
+
+	return resourceCloudProjectGatewayInterfaceRead(d, meta)
+}
+
+func resourceCloudProjectGatewayInterfaceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	region := d.Get("region").(string)
+	gatewayId := d.Get("gateway_id").(string)
+
+	log.Printf("[DEBUG] Will read interface %s of gateway %s", d.Id(), gatewayId)
+
+	endpoint := cloudProjectGatewayInterfaceEndpoint(serviceName, region, gatewayId, d.Id())
+	r := &CloudProjectGatewayInterface{}
+	if err := config.OVHClient.Get(endpoint, r); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	for k, v := range r.ToMap() {
+		d.Set(k, v)
+	}
+
+	return nil
+}
+
+func resourceCloudProjectGatewayInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	region := d.Get("region").(string)
+	gatewayId := d.Get("gateway_id").(string)
+
+	if err := waitForCloudProjectGatewayReady(config.OVHClient, serviceName, region, gatewayId, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Will delete interface %s of gateway %s", d.Id(), gatewayId)
+
+	endpoint := cloudProjectGatewayInterfaceEndpoint(serviceName, region, gatewayId, d.Id())
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	if err := waitForCloudProjectGatewayReady(config.OVHClient, serviceName, region, gatewayId, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
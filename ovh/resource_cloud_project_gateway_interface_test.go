@@ -0,0 +1,103 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var testAccCloudProjectGatewayInterfaceConfig = `
+resource "ovh_vrack_cloudproject" "attach" {
+	service_name = "%s"
+	project_id   = "%s"
+}
+
+resource "ovh_cloud_project_network_private" "mypriv" {
+  service_name  = ovh_vrack_cloudproject.attach.project_id
+  vlan_id       = "%d"
+  name          = "%s"
+  regions       = ["%s"]
+}
+
+resource "ovh_cloud_project_network_private_subnet" "myprivsub" {
+  service_name  = ovh_cloud_project_network_private.mypriv.service_name
+  network_id    = ovh_cloud_project_network_private.mypriv.id
+  region        = "%s"
+  start         = "10.0.0.2"
+  end           = "10.0.255.254"
+  network       = "10.0.0.0/16"
+  dhcp          = true
+}
+
+resource "ovh_cloud_project_network_private_subnet_v2" "other" {
+  service_name = ovh_cloud_project_network_private_subnet.myprivsub.service_name
+  region       = ovh_cloud_project_network_private_subnet.myprivsub.region
+  network_id   = tolist(ovh_cloud_project_network_private.mypriv.regions_attributes[*].openstackid)[0]
+  name         = "other"
+  cidr         = "10.1.0.0/24"
+}
+
+resource "ovh_cloud_project_gateway" "gateway" {
+  service_name = ovh_cloud_project_network_private.mypriv.service_name
+  name          = "%s"
+  model         = "s"
+  region        = ovh_cloud_project_network_private_subnet.myprivsub.region
+  network_id    = tolist(ovh_cloud_project_network_private.mypriv.regions_attributes[*].openstackid)[0]
+  subnet_id     = ovh_cloud_project_network_private_subnet.myprivsub.id
+}
+
+resource "ovh_cloud_project_gateway_interface" "itf" {
+  service_name = ovh_cloud_project_gateway.gateway.service_name
+  region       = ovh_cloud_project_gateway.gateway.region
+  gateway_id   = ovh_cloud_project_gateway.gateway.id
+  subnet_id    = ovh_cloud_project_network_private_subnet_v2.other.id
+}
+`
+
+func TestAccCloudProjectGatewayInterface_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	region := os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST")
+	resourcePath := "ovh_cloud_project_gateway_interface.itf"
+
+	config := fmt.Sprintf(
+		testAccCloudProjectGatewayInterfaceConfig,
+		os.Getenv("OVH_VRACK_SERVICE_TEST"),
+		serviceName,
+		acctest.RandIntRange(100, 200),
+		acctest.RandomWithPrefix(test_prefix),
+		region,
+		region,
+		acctest.RandomWithPrefix(test_prefix),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloud(t)
+			testAccCheckCloudProjectExists(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourcePath, "subnet_id", "ovh_cloud_project_network_private_subnet_v2.other", "id"),
+					resource.TestCheckResourceAttrPair(resourcePath, "network_id", "ovh_cloud_project_network_private_subnet_v2.other", "network_id"),
+					resource.TestCheckResourceAttrSet(resourcePath, "ip"),
+				),
+			},
+			{
+				ResourceName:      resourcePath,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					itf := s.RootModule().Resources[resourcePath]
+					return fmt.Sprintf("%s/%s/%s/%s", serviceName, region, itf.Primary.Attributes["gateway_id"], itf.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
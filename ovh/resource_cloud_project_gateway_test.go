@@ -3,10 +3,12 @@ package ovh
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 var testAccCloudProjectGatewayConfig = `
@@ -40,13 +42,49 @@ resource "ovh_cloud_project_network_private_subnet" "myprivsub" {
 resource "ovh_cloud_project_gateway" "gateway" {
   service_name = ovh_cloud_project_network_private.mypriv.service_name
   name          = "%s"
-  model         = "s"
+  model         = "%s"
   region        = ovh_cloud_project_network_private_subnet.myprivsub.region
   network_id    = tolist(ovh_cloud_project_network_private.mypriv.regions_attributes[*].openstackid)[0]
   subnet_id     = ovh_cloud_project_network_private_subnet.myprivsub.id
 }
 `
 
+func Test_cloudProjectGatewayModelIsDowngrade(t *testing.T) {
+	tests := []struct {
+		o, n string
+		want bool
+	}{
+		{"s", "m", false},
+		{"m", "l", false},
+		{"s", "l", false},
+		{"l", "m", true},
+		{"m", "s", true},
+		{"m", "m", false},
+	}
+	for _, tt := range tests {
+		if got := cloudProjectGatewayModelIsDowngrade(tt.o, tt.n); got != tt.want {
+			t.Errorf("cloudProjectGatewayModelIsDowngrade(%q, %q) = %v, want %v", tt.o, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestCloudProjectGatewayResponse_SnatIp(t *testing.T) {
+	r := CloudProjectGatewayResponse{}
+	if ip := r.SnatIp(); ip != "" {
+		t.Errorf("SnatIp() = %q without external information, want empty", ip)
+	}
+
+	r.ExternalInformation = &CloudProjectGatewayExternal{
+		Ips: []*CloudProjectGatewayExternalIp{
+			{Ip: "2001:db8::1"},
+			{Ip: "203.0.113.10"},
+		},
+	}
+	if ip := r.SnatIp(); ip != "203.0.113.10" {
+		t.Errorf("SnatIp() = %q, want 203.0.113.10", ip)
+	}
+}
+
 func TestAccCloudProjectGateway(t *testing.T) {
 	name := acctest.RandomWithPrefix(test_prefix)
 	gatewayName := acctest.RandomWithPrefix(test_prefix)
@@ -54,17 +92,20 @@ func TestAccCloudProjectGateway(t *testing.T) {
 	region := os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST")
 	resourcePath := "ovh_cloud_project_gateway.gateway"
 
-	config := fmt.Sprintf(
-		testAccCloudProjectGatewayConfig,
-		os.Getenv("OVH_VRACK_SERVICE_TEST"),
-		os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST"),
-		os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST"),
-		vlanId,
-		name,
-		region,
-		region,
-		gatewayName,
-	)
+	config := func(model string) string {
+		return fmt.Sprintf(
+			testAccCloudProjectGatewayConfig,
+			os.Getenv("OVH_VRACK_SERVICE_TEST"),
+			os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST"),
+			os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST"),
+			vlanId,
+			name,
+			region,
+			region,
+			gatewayName,
+			model,
+		)
+	}
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -75,7 +116,7 @@ func TestAccCloudProjectGateway(t *testing.T) {
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: config,
+				Config: config("s"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourcePath, "service_name"),
 					resource.TestCheckResourceAttrSet(resourcePath, "network_id"),
@@ -91,8 +132,26 @@ func TestAccCloudProjectGateway(t *testing.T) {
 					resource.TestCheckResourceAttr(resourcePath, "region", region),
 					resource.TestCheckResourceAttr(resourcePath, "name", gatewayName),
 					resource.TestCheckResourceAttr(resourcePath, "model", "s"),
+					resource.TestCheckResourceAttrSet(resourcePath, "snat_ip"),
 				),
 			},
+			{
+				Config: config("m"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourcePath, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourcePath, "model", "m"),
+					resource.TestCheckResourceAttr(resourcePath, "status", "active"),
+					resource.TestCheckResourceAttrSet(resourcePath, "snat_ip"),
+				),
+			},
+			{
+				Config:      config("s"),
+				ExpectError: regexp.MustCompile("gateway model can only be upgraded"),
+			},
 		},
 	})
 }
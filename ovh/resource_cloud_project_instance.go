@@ -0,0 +1,495 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+const (
+	cloudProjectInstanceBillingPeriodHourly  = "hourly"
+	cloudProjectInstanceBillingPeriodMonthly = "monthly"
+)
+
+// cloudProjectInstancePendingStatus are the statuses of an instance while an action is in progress
+var cloudProjectInstancePendingStatus = []string{"BUILD", "BUILDING", "REBUILD", "RESIZE", "VERIFY_RESIZE", "REBOOT", "HARD_REBOOT", "MIGRATING"}
+
+func resourceCloudProjectInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudProjectInstanceCreate,
+		Read:   resourceCloudProjectInstanceRead,
+		Update: resourceCloudProjectInstanceUpdate,
+		Delete: resourceCloudProjectInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudProjectInstanceImportState,
+		},
+
+		CustomizeDiff: resourceCloudProjectInstanceCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+				Description: "Service name of the resource representing the id of the cloud project.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Description: "Region of the instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the instance",
+				Required:    true,
+			},
+			"flavor_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the flavor of the instance, changing it resizes the instance",
+				Required:    true,
+			},
+			"image_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the image the instance is created from",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ssh_key_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the SSH key of the project to install on the instance",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"user_data": {
+				Type:        schema.TypeString,
+				Description: "Configuration script run when the instance boots for the first time, e.g. cloud-init",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Description: "Availability zone of the instance, for the regions with several zones",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"billing_period": {
+				Type:         schema.TypeString,
+				Description:  "Billing period of the instance, hourly or monthly",
+				Optional:     true,
+				Default:      cloudProjectInstanceBillingPeriodHourly,
+				ValidateFunc: helpers.ValidateEnum([]string{cloudProjectInstanceBillingPeriodHourly, cloudProjectInstanceBillingPeriodMonthly}),
+			},
+			"check_quota": {
+				Type:        schema.TypeBool,
+				Description: "Fail the plan, instead of logging a warning, when the instance to create, or the resized one, doesn't fit in the quotas left in its region",
+				Optional:    true,
+				Default:     false,
+			},
+			"network": {
+				Type:        schema.TypeList,
+				Description: "Networks of the instance",
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"public": {
+							Type:        schema.TypeBool,
+							Description: "Attach the instance to the public network",
+							Optional:    true,
+							ForceNew:    true,
+							Default:     true,
+						},
+						"private": {
+							Type:        schema.TypeList,
+							Description: "Private network the instance is attached to",
+							Optional:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"network_id": {
+										Type:        schema.TypeString,
+										Description: "Openstack ID of the private network",
+										Required:    true,
+										ForceNew:    true,
+									},
+									"subnet_id": {
+										Type:        schema.TypeString,
+										Description: "ID of the subnet of the private network",
+										Optional:    true,
+										ForceNew:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"autobackup": {
+				Type:        schema.TypeList,
+				Description: "Automatic backups of the instance",
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cron": {
+							Type:        schema.TypeString,
+							Description: "Schedule of the backups, in cron format",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"rotation": {
+							Type:        schema.TypeInt,
+							Description: "Number of backups to keep",
+							Required:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
+
+			// computed
+			"current_image_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the image the instance boots from, the snapshot it was restored from after a restore",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Status of the instance",
+				Computed:    true,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: "Creation date of the instance",
+				Computed:    true,
+			},
+			"flavor_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the flavor of the instance",
+				Computed:    true,
+			},
+			"public_ipv4": {
+				Type:        schema.TypeString,
+				Description: "Public IPv4 address of the instance",
+				Computed:    true,
+			},
+			"ip_addresses": {
+				Type:        schema.TypeList,
+				Description: "IP addresses of the instance",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:        schema.TypeString,
+							Description: "IP address",
+							Computed:    true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Description: "public or private",
+							Computed:    true,
+						},
+						"version": {
+							Type:        schema.TypeInt,
+							Description: "IP version",
+							Computed:    true,
+						},
+						"network_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the network of the IP address",
+							Computed:    true,
+						},
+						"gateway_ip": {
+							Type:        schema.TypeString,
+							Description: "Gateway IP address",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCloudProjectInstanceImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("import Id is not service_name/instance_id formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+	d.Set("check_quota", false)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceCloudProjectInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	region := d.Get("region").(string)
+
+	params := (&CloudProjectInstanceCreateOpts{}).FromResource(d)
+	endpoint := fmt.Sprintf("/cloud/project/%s/region/%s/instance",
+		url.PathEscape(serviceName),
+		url.PathEscape(region))
+	op := &CloudProjectOperationResponse{}
+
+	log.Printf("[DEBUG] Will create public cloud instance: %s", params)
+	if err := config.OVHClient.Post(endpoint, params, op); err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %w", endpoint, params, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for operation %s creating instance %s", op.Id, params.Name)
+	op, err := waitForCloudProjectOperation(config.OVHClient, serviceName, op.Id, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	id := op.GetResourceId()
+	if id == "" {
+		return fmt.Errorf("operation %s creating instance %s has no resource id", op.Id, params.Name)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Waiting for instance %s to be ACTIVE", id)
+	if err := waitForCloudProjectInstanceActive(config.OVHClient, serviceName, id, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceCloudProjectInstanceRead(d, meta)
+}
+
+func resourceCloudProjectInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()))
+	res := &CloudProjectInstanceResponse{}
+
+	log.Printf("[DEBUG] Will read instance %s in project %s", d.Id(), serviceName)
+	if err := config.OVHClient.Get(endpoint, res); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	// An instance rebuilt from a snapshot of the project, e.g. by ovh_cloud_project_instance_restore,
+	// boots from this snapshot: keep the image it was created from so that it isn't replaced
+	imageId := d.Get("image_id").(string)
+	if imageId != "" && imageId != res.ImageId {
+		isSnapshot, err := isCloudProjectSnapshot(config.OVHClient, serviceName, res.ImageId)
+		if err != nil {
+			return err
+		}
+		if !isSnapshot {
+			imageId = res.ImageId
+		}
+	} else {
+		imageId = res.ImageId
+	}
+
+	for k, v := range res.ToMap() {
+		d.Set(k, v)
+	}
+	d.Set("image_id", imageId)
+	d.Set("current_image_id", res.ImageId)
+
+	// The networks aren't returned by the API: when importing, guess them from the IP addresses
+	if len(d.Get("network").([]interface{})) == 0 {
+		d.Set("network", res.NetworkToMap())
+	}
+
+	log.Printf("[DEBUG] Read instance: %+v", res)
+	return nil
+}
+
+func resourceCloudProjectInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()))
+
+	if d.HasChange("name") {
+		params := &CloudProjectInstanceUpdateOpts{InstanceName: d.Get("name").(string)}
+
+		log.Printf("[DEBUG] Will rename instance %s: %+v", d.Id(), params)
+		if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+			return fmt.Errorf("calling Put %s with params %+v:\n\t %w", endpoint, params, err)
+		}
+	}
+
+	if d.HasChange("flavor_id") {
+		params := &CloudProjectInstanceResizeOpts{FlavorId: d.Get("flavor_id").(string)}
+
+		log.Printf("[DEBUG] Will resize instance %s: %+v", d.Id(), params)
+		if err := config.OVHClient.Post(endpoint+"/resize", params, nil); err != nil {
+			return fmt.Errorf("calling Post %s/resize with params %+v:\n\t %w", endpoint, params, err)
+		}
+
+		log.Printf("[DEBUG] Waiting for instance %s to be ACTIVE", d.Id())
+		if err := waitForCloudProjectInstanceActive(config.OVHClient, serviceName, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	// The CustomizeDiff only lets the switch from hourly to monthly through
+	if d.HasChange("billing_period") {
+		log.Printf("[DEBUG] Will activate monthly billing on instance %s", d.Id())
+		if err := config.OVHClient.Post(endpoint+"/activeMonthlyBilling", nil, nil); err != nil {
+			return fmt.Errorf("calling Post %s/activeMonthlyBilling:\n\t %w", endpoint, err)
+		}
+	}
+
+	return resourceCloudProjectInstanceRead(d, meta)
+}
+
+func resourceCloudProjectInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()))
+
+	log.Printf("[DEBUG] Will delete instance %s in project %s", d.Id(), serviceName)
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Waiting for instance %s to be DELETED", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE", "DELETING", "SHUTOFF", "STOPPED"},
+		Target:     []string{"DELETED"},
+		Refresh:    cloudProjectInstanceStatusRefreshFunc(config.OVHClient, serviceName, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for instance %s to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudProjectInstanceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Monthly billing can be activated on an existing instance but not deactivated
+	if d.Id() != "" && d.HasChange("billing_period") {
+		if old, _ := d.GetChange("billing_period"); old.(string) == cloudProjectInstanceBillingPeriodMonthly {
+			if err := d.ForceNew("billing_period"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return reportCloudProjectQuota(d, checkCloudProjectInstanceQuota(d, meta.(*Config)))
+}
+
+// checkCloudProjectInstanceQuota checks the instance to create, or the resized one,
+// fits in the quotas left in its region
+func checkCloudProjectInstanceQuota(d *schema.ResourceDiff, config *Config) error {
+	if d.Id() != "" && !d.HasChange("flavor_id") {
+		return nil
+	}
+	if !d.NewValueKnown("service_name") || !d.NewValueKnown("region") || !d.NewValueKnown("flavor_id") {
+		return nil
+	}
+	serviceName := d.Get("service_name").(string)
+
+	oldFlavorId, newFlavorId := d.GetChange("flavor_id")
+	flavor, err := getCloudProjectFlavor(config.OVHClient, serviceName, newFlavorId.(string))
+	if err != nil {
+		return fmt.Errorf("unable to check the quotas of project %s: %w", serviceName, err)
+	}
+	req := CloudProjectQuotaRequest{Instances: 1, Cores: flavor.Vcpus, Ram: flavor.Ram}
+
+	if d.Id() != "" {
+		oldFlavor, err := getCloudProjectFlavor(config.OVHClient, serviceName, oldFlavorId.(string))
+		if err != nil {
+			return fmt.Errorf("unable to check the quotas of project %s: %w", serviceName, err)
+		}
+		req = CloudProjectQuotaRequest{Cores: flavor.Vcpus - oldFlavor.Vcpus, Ram: flavor.Ram - oldFlavor.Ram}
+	}
+
+	return checkCloudProjectQuota(config.OVHClient, serviceName, d.Get("region").(string), req)
+}
+
+// isCloudProjectSnapshot tells whether the image is a snapshot of the project
+func isCloudProjectSnapshot(c *ovh.Client, serviceName, imageId string) (bool, error) {
+	endpoint := fmt.Sprintf("/cloud/project/%s/snapshot/%s", url.PathEscape(serviceName), url.PathEscape(imageId))
+	if err := c.Get(endpoint, &CloudProjectSnapshotResponse{}); err != nil {
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+			return false, nil
+		}
+		return false, fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+	return true, nil
+}
+
+func getCloudProjectFlavor(c *ovh.Client, serviceName, flavorId string) (*CloudProjectInstanceFlavor, error) {
+	endpoint := fmt.Sprintf("/cloud/project/%s/flavor/%s", url.PathEscape(serviceName), url.PathEscape(flavorId))
+	res := &CloudProjectInstanceFlavor{}
+	if err := c.Get(endpoint, res); err != nil {
+		return nil, fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+	return res, nil
+}
+
+func waitForCloudProjectInstanceActive(c *ovh.Client, serviceName, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    cloudProjectInstancePendingStatus,
+		Target:     []string{"ACTIVE"},
+		Refresh:    cloudProjectInstanceStatusRefreshFunc(c, serviceName, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for instance %s to be ACTIVE: %s", id, err)
+	}
+	return nil
+}
+
+// cloudProjectInstanceStatusRefreshFunc returns the status of an instance, or DELETED once it's gone
+func cloudProjectInstanceStatusRefreshFunc(c *ovh.Client, serviceName, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res := &CloudProjectInstanceResponse{}
+		endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s",
+			url.PathEscape(serviceName),
+			url.PathEscape(id))
+		if err := c.Get(endpoint, res); err != nil {
+			if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+				return res, "DELETED", nil
+			}
+			return res, "", err
+		}
+
+		log.Printf("[DEBUG] Pending instance: %s is %s", id, res.Status)
+		return res, res.Status, nil
+	}
+}
@@ -0,0 +1,132 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceCloudProjectInstanceRestore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudProjectInstanceRestoreCreate,
+		Read:   resourceCloudProjectInstanceRestoreRead,
+		Delete: resourceCloudProjectInstanceRestoreDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+				Description: "Service name of the resource representing the ID of the cloud project.",
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the instance to rebuild",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"snapshot_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the snapshot to rebuild the instance from, changing it rebuilds the instance again",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			// Computed
+			"region": {
+				Type:        schema.TypeString,
+				Description: "Region of the instance",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Status of the instance",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceCloudProjectInstanceRestoreCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	instanceId := d.Get("instance_id").(string)
+	snapshotId := d.Get("snapshot_id").(string)
+
+	instanceEndpoint := fmt.Sprintf("/cloud/project/%s/instance/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(instanceId))
+	instance := &CloudProjectInstanceResponse{}
+	if err := config.OVHClient.Get(instanceEndpoint, instance); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %w", instanceEndpoint, err)
+	}
+
+	snapshotEndpoint := fmt.Sprintf("/cloud/project/%s/snapshot/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(snapshotId))
+	snapshot := &CloudProjectSnapshotResponse{}
+	if err := config.OVHClient.Get(snapshotEndpoint, snapshot); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %w", snapshotEndpoint, err)
+	}
+
+	if snapshot.Region != instance.Region {
+		return fmt.Errorf("snapshot %s is in region %s, it can't be restored on instance %s in region %s",
+			snapshotId, snapshot.Region, instanceId, instance.Region)
+	}
+	if snapshot.Status != "active" {
+		return fmt.Errorf("snapshot %s is %s, it must be active to be restored", snapshotId, snapshot.Status)
+	}
+
+	params := &CloudProjectInstanceReinstallOpts{ImageId: snapshotId}
+
+	log.Printf("[DEBUG] Will rebuild instance %s from snapshot %s", instanceId, snapshotId)
+	if err := config.OVHClient.Post(instanceEndpoint+"/reinstall", params, nil); err != nil {
+		return fmt.Errorf("calling Post %s/reinstall with params %+v:\n\t %w", instanceEndpoint, params, err)
+	}
+
+	d.SetId(instanceId)
+
+	log.Printf("[DEBUG] Waiting for instance %s to be ACTIVE", instanceId)
+	if err := waitForCloudProjectInstanceActive(config.OVHClient, serviceName, instanceId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceCloudProjectInstanceRestoreRead(d, meta)
+}
+
+func resourceCloudProjectInstanceRestoreRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()))
+	res := &CloudProjectInstanceResponse{}
+
+	log.Printf("[DEBUG] Will read instance %s in project %s", d.Id(), serviceName)
+	if err := config.OVHClient.Get(endpoint, res); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("region", res.Region)
+	d.Set("status", res.Status)
+
+	return nil
+}
+
+// resourceCloudProjectInstanceRestoreDelete only removes the restore from the state,
+// the instance is left as it is
+func resourceCloudProjectInstanceRestoreDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Will forget restore of instance %s, the instance is kept", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,47 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccCloudProjectInstanceRestoreConfig = `
+resource "ovh_cloud_project_instance_restore" "restore" {
+  service_name = "%s"
+  instance_id  = "%s"
+  snapshot_id  = "%s"
+}
+`
+
+func TestAccCloudProjectInstanceRestore_basic(t *testing.T) {
+	serviceName := os.Getenv(CLOUD_PROJECT_TEST_ENV_VAR)
+	instanceId := os.Getenv(WORKFLOW_BACKUP_TEST_INSTANCE_ID_ENV_VAR)
+	config := fmt.Sprintf(testAccCloudProjectInstanceRestoreConfig,
+		serviceName,
+		instanceId,
+		os.Getenv("OVH_CLOUD_PROJECT_INSTANCE_RESTORE_SNAPSHOT_ID_TEST"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloud(t)
+			testAccCheckCloudProjectExists(t)
+			testAccPreCheckWorkflowBackup(t)
+			checkEnvOrSkip(t, "OVH_CLOUD_PROJECT_INSTANCE_RESTORE_SNAPSHOT_ID_TEST")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_instance_restore.restore", "instance_id", instanceId),
+					resource.TestCheckResourceAttr("ovh_cloud_project_instance_restore.restore", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_instance_restore.restore", "region", os.Getenv(WORKFLOW_BACKUP_TEST_REGION_ENV_VAR)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,196 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("ovh_cloud_project_instance", &resource.Sweeper{
+		Name: "ovh_cloud_project_instance",
+		F:    testSweepCloudProjectInstance,
+	})
+}
+
+func testSweepCloudProjectInstance(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	if serviceName == "" {
+		log.Print("[DEBUG] OVH_CLOUD_PROJECT_SERVICE_TEST is not set. No instance to sweep")
+		return nil
+	}
+
+	instances := make([]CloudProjectInstanceResponse, 0)
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance", serviceName)
+	if err := client.Get(endpoint, &instances); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	for _, instance := range instances {
+		if !strings.HasPrefix(instance.Name, test_prefix) {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting instance %s/%s", instance.Name, instance.Id)
+		if err := client.Delete(fmt.Sprintf("%s/%s", endpoint, instance.Id), nil); err != nil {
+			return fmt.Errorf("Error deleting instance %s:\n\t %q", instance.Id, err)
+		}
+	}
+	return nil
+}
+
+func TestCloudProjectOperationResponse_GetResourceId(t *testing.T) {
+	id := "instance-id"
+	empty := ""
+
+	tests := []struct {
+		name string
+		op   CloudProjectOperationResponse
+		want string
+	}{
+		{name: "own resource", op: CloudProjectOperationResponse{ResourceId: &id}, want: id},
+		{name: "no resource", op: CloudProjectOperationResponse{ResourceId: &empty}, want: ""},
+		{
+			name: "sub operation",
+			op: CloudProjectOperationResponse{
+				SubOperations: []*CloudProjectOperationResponse{
+					nil,
+					{ResourceId: &empty},
+					{ResourceId: &id},
+				},
+			},
+			want: id,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.op.GetResourceId(); got != tt.want {
+				t.Errorf("GetResourceId() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCloudProjectInstanceResponse_NetworkToMap(t *testing.T) {
+	instance := CloudProjectInstanceResponse{
+		IpAddresses: []CloudProjectInstanceIpAddress{
+			{Ip: "51.68.0.10", Type: "public", Version: 4, NetworkId: "ext-net"},
+			{Ip: "2001:db8::1", Type: "public", Version: 6, NetworkId: "ext-net"},
+			{Ip: "10.0.0.12", Type: "private", Version: 4, NetworkId: "priv-net"},
+		},
+	}
+
+	want := []map[string]interface{}{{
+		"public":  true,
+		"private": []map[string]interface{}{{"network_id": "priv-net"}},
+	}}
+	if got := instance.NetworkToMap(); !reflect.DeepEqual(got, want) {
+		t.Errorf("NetworkToMap() = %v, want %v", got, want)
+	}
+}
+
+var testAccCloudProjectInstanceConfig = `
+resource "ovh_cloud_project_instance" "instance" {
+  service_name   = "%s"
+  region         = "%s"
+  name           = "%s"
+  flavor_id      = "%s"
+  image_id       = "%s"
+  billing_period = "hourly"
+  user_data      = "#cloud-config\nruncmd:\n  - echo ready\n"
+
+  network {
+    public = true
+  }
+}
+`
+
+func TestAccCloudProjectInstance_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	region := os.Getenv("OVH_CLOUD_PROJECT_REGION_TEST")
+	flavorId := os.Getenv("OVH_CLOUD_PROJECT_INSTANCE_FLAVOR_ID_TEST")
+	imageId := os.Getenv("OVH_CLOUD_PROJECT_INSTANCE_IMAGE_ID_TEST")
+	name := acctest.RandomWithPrefix(test_prefix)
+	updatedName := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloudInstance(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudProjectInstanceConfig, serviceName, region, name, flavorId, imageId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_instance.instance", "name", name),
+					resource.TestCheckResourceAttr("ovh_cloud_project_instance.instance", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_instance.instance", "flavor_id", flavorId),
+					resource.TestCheckResourceAttr("ovh_cloud_project_instance.instance", "billing_period", "hourly"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_instance.instance", "public_ipv4"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_instance.instance", "ip_addresses.#"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCloudProjectInstanceConfig, serviceName, region, updatedName, flavorId, imageId),
+				Check:  resource.TestCheckResourceAttr("ovh_cloud_project_instance.instance", "name", updatedName),
+			},
+			{
+				ResourceName:            "ovh_cloud_project_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     serviceName + "/",
+				ImportStateVerifyIgnore: []string{"user_data"},
+			},
+		},
+	})
+}
+
+func TestAccCloudProjectInstance_restore(t *testing.T) {
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	region := os.Getenv(WORKFLOW_BACKUP_TEST_REGION_ENV_VAR)
+	flavorId := os.Getenv("OVH_CLOUD_PROJECT_INSTANCE_FLAVOR_ID_TEST")
+	imageId := os.Getenv("OVH_CLOUD_PROJECT_INSTANCE_IMAGE_ID_TEST")
+	snapshotId := os.Getenv("OVH_CLOUD_PROJECT_INSTANCE_RESTORE_SNAPSHOT_ID_TEST")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	config := fmt.Sprintf(testAccCloudProjectInstanceConfig, serviceName, region, name, flavorId, imageId) + fmt.Sprintf(`
+resource "ovh_cloud_project_instance_restore" "restore" {
+  service_name = ovh_cloud_project_instance.instance.service_name
+  instance_id  = ovh_cloud_project_instance.instance.id
+  snapshot_id  = "%s"
+}
+`, snapshotId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloudInstance(t)
+			testAccPreCheckWorkflowBackup(t)
+			checkEnvOrSkip(t, "OVH_CLOUD_PROJECT_INSTANCE_RESTORE_SNAPSHOT_ID_TEST")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				// the restored instance boots from the snapshot but must not be replaced
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_instance.instance", "image_id", imageId),
+					resource.TestCheckResourceAttr("ovh_cloud_project_instance.instance", "current_image_id", snapshotId),
+				),
+			},
+		},
+	})
+}
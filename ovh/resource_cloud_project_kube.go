@@ -15,9 +15,12 @@ import (
 )
 
 const (
+	kubeClusterDeletionProtectionKey          = "deletion_protection"
+	kubeClusterInitialNodePoolKey             = "initial_nodepool"
 	kubeClusterLoadBalancersSubnetIdKey       = "load_balancers_subnet_id"
 	kubeClusterNodesSubnetIdKey               = "nodes_subnet_id"
 	kubeClusterNameKey                        = "name"
+	kubeClusterPreventDeletionWithNodesKey    = "prevent_deletion_with_running_nodes"
 	kubeClusterPrivateNetworkIDKey            = "private_network_id"
 	kubeClusterPrivateNetworkConfigurationKey = "private_network_configuration"
 	kubeClusterUpdatePolicyKey                = "update_policy"
@@ -293,6 +296,98 @@ func resourceCloudProjectKube() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			kubeClusterDeletionProtectionKey: {
+				Type:        schema.TypeBool,
+				Description: "Prevent the cluster from being deleted",
+				Optional:    true,
+				Default:     false,
+			},
+			kubeClusterPreventDeletionWithNodesKey: {
+				Type:        schema.TypeBool,
+				Description: "Prevent the cluster from being deleted while some of its nodepools have nodes",
+				Optional:    true,
+				Default:     false,
+			},
+			kubeClusterInitialNodePoolKey: {
+				Type:        schema.TypeList,
+				Description: "Nodepool created along with the cluster, its changes are ignored once the cluster is created",
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				// Also suppresses the diff of the nested attributes
+				DiffSuppressFunc: kubeClusterInitialNodePoolDiffSuppress,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "NodePool resource name",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"flavor_name": {
+							Type:        schema.TypeString,
+							Description: "Flavor name",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"desired_nodes": {
+							Type:        schema.TypeInt,
+							Description: "Number of nodes you desire in the pool",
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"max_nodes": {
+							Type:        schema.TypeInt,
+							Description: "Maximum number of nodes allowed in the pool",
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"min_nodes": {
+							Type:        schema.TypeInt,
+							Description: "Minimum number of nodes allowed in the pool",
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"autoscale": {
+							Type:        schema.TypeBool,
+							Description: "Enable auto-scaling for the pool",
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"autoscaling_scale_down_unneeded_time_seconds": {
+							Type:        schema.TypeInt,
+							Description: "scaleDownUnneededTimeSeconds for autoscaling",
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"autoscaling_scale_down_unready_time_seconds": {
+							Type:        schema.TypeInt,
+							Description: "scaleDownUnreadyTimeSeconds for autoscaling",
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"autoscaling_scale_down_utilization_threshold": {
+							Type:        schema.TypeFloat,
+							Description: "scaleDownUtilizationThreshold for autoscaling",
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"anti_affinity": {
+							Type:        schema.TypeBool,
+							Description: "Enable anti affinity groups for nodes in the pool",
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"monthly_billed": {
+							Type:        schema.TypeBool,
+							Description: "Enable monthly billing on all nodes in the pool",
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"template": kubeNodePoolTemplateSchema(true),
+					},
+				},
+			},
 
 			// Computed
 			"control_plane_is_up_to_date": {
@@ -429,6 +524,8 @@ func resourceCloudProjectKubeImportState(d *schema.ResourceData, meta interface{
 	id := splitId[1]
 	d.SetId(id)
 	d.Set("service_name", serviceName)
+	d.Set(kubeClusterDeletionProtectionKey, false)
+	d.Set(kubeClusterPreventDeletionWithNodesKey, false)
 
 	// add kubeconfig in state
 	if err := setKubeconfig(d, meta); err != nil {
@@ -443,9 +540,13 @@ func resourceCloudProjectKubeImportState(d *schema.ResourceData, meta interface{
 func resourceCloudProjectKubeCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	serviceName := d.Get("service_name").(string)
+	// The waits below share the create timeout
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
 
 	params := new(CloudProjectKubeCreateOpts)
-	params.FromResource(d)
+	if err := params.FromResource(d); err != nil {
+		return err
+	}
 
 	res := &CloudProjectKubeResponse{}
 
@@ -457,18 +558,27 @@ func resourceCloudProjectKubeCreate(d *schema.ResourceData, meta interface{}) er
 
 	log.Printf("[DEBUG] Waiting for kube %s to be available", res.Id)
 	endpoint = fmt.Sprintf("/cloud/project/%s/kube/%s", serviceName, res.Id)
-	if err := helpers.WaitAvailable(config.OVHClient, endpoint, d.Timeout(schema.TimeoutCreate)); err != nil {
+	if err := helpers.WaitAvailable(config.OVHClient, endpoint, time.Until(deadline)); err != nil {
 		return err
 	}
 
 	log.Printf("[DEBUG] Waiting for kube %s to be READY", res.Id)
-	if err := waitForCloudProjectKubeReady(config.OVHClient, serviceName, res.Id, []string{"INSTALLING"}, []string{"READY"}, d.Timeout(schema.TimeoutCreate)); err != nil {
+	if err := waitForCloudProjectKubeReady(config.OVHClient, serviceName, res.Id, []string{"INSTALLING"}, []string{"READY"}, time.Until(deadline)); err != nil {
 		return fmt.Errorf("timeout while waiting kube %s to be READY: %w", res.Id, err)
 	}
 
 	log.Printf("[DEBUG] kube %s is READY", res.Id)
 	d.SetId(res.Id)
 
+	if params.NodePool != nil {
+		nodePoolName := *params.NodePool.Name
+		log.Printf("[DEBUG] Waiting for initial nodepool %s of kube %s to be READY", nodePoolName, res.Id)
+		if err := waitForCloudProjectKubeInitialNodePoolReady(config.OVHClient, serviceName, res.Id, nodePoolName, time.Until(deadline)); err != nil {
+			return fmt.Errorf("timeout while waiting initial nodepool %s of kube %s to be READY: %w", nodePoolName, res.Id, err)
+		}
+		log.Printf("[DEBUG] initial nodepool %s of kube %s is READY", nodePoolName, res.Id)
+	}
+
 	return resourceCloudProjectKubeRead(d, meta)
 }
 
@@ -507,6 +617,25 @@ func resourceCloudProjectKubeDelete(d *schema.ResourceData, meta interface{}) er
 	config := meta.(*Config)
 	serviceName := d.Get("service_name").(string)
 
+	if d.Get(kubeClusterDeletionProtectionKey).(bool) {
+		return fmt.Errorf("kube %s can't be deleted while %s is set to true", d.Id(), kubeClusterDeletionProtectionKey)
+	}
+
+	if d.Get(kubeClusterPreventDeletionWithNodesKey).(bool) {
+		var nodePools []CloudProjectKubeNodePoolResponse
+		endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool", serviceName, d.Id())
+		if err := config.OVHClient.Get(endpoint, &nodePools); err != nil {
+			return helpers.CheckDeleted(d, err, endpoint)
+		}
+
+		for _, nodePool := range nodePools {
+			if nodePool.CurrentNodes > 0 {
+				return fmt.Errorf("kube %s can't be deleted while %s is set to true, nodepool %s still has %d nodes",
+					d.Id(), kubeClusterPreventDeletionWithNodesKey, nodePool.Name, nodePool.CurrentNodes)
+			}
+		}
+	}
+
 	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s", serviceName, d.Id())
 
 	log.Printf("[DEBUG] Will delete kube %s from project: %s", d.Id(), serviceName)
@@ -724,6 +853,45 @@ func waitForCloudProjectKubeReady(client *ovh.Client, serviceName, kubeId string
 	return err
 }
 
+// kubeClusterInitialNodePoolDiffSuppress ignores the changes of the initial nodepool once the cluster is created:
+// it isn't read back, the nodepool being managed as any other one afterwards
+func kubeClusterInitialNodePoolDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+// waitForCloudProjectKubeInitialNodePoolReady waits for the nodepool created along with the cluster.
+// Its id isn't known from the cluster creation response, so it's looked up by name.
+func waitForCloudProjectKubeInitialNodePoolReady(client *ovh.Client, serviceName, kubeId, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"NOT_FOUND", "INSTALLING", "UPDATING", "REDEPLOYING", "RESIZING", "DOWNSCALING", "UPSCALING"},
+		Target:  []string{"READY"},
+		Refresh: func() (interface{}, string, error) {
+			var nodePools []CloudProjectKubeNodePoolResponse
+			endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool", serviceName, kubeId)
+			if err := client.Get(endpoint, &nodePools); err != nil {
+				return nil, "", err
+			}
+
+			for _, nodePool := range nodePools {
+				if nodePool.Name == name {
+					if nodePool.Status == "ERROR" {
+						return nil, "", fmt.Errorf("nodepool %s is in ERROR", name)
+					}
+					return nodePool, nodePool.Status, nil
+				}
+			}
+
+			return nodePools, "NOT_FOUND", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
 func waitForCloudProjectKubeDeleted(d *schema.ResourceData, client *ovh.Client, serviceName, kubeId string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"DELETING"},
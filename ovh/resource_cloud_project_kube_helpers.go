@@ -44,11 +44,28 @@ type KubectlUserWithName struct {
 }
 
 type KubectlUser struct {
-	ClientCertificateData string `json:"client-certificate-data,omitempty" yaml:"client-certificate-data,omitempty"`
-	ClientKeyData         string `json:"client-key-data,omitempty" yaml:"client-key-data,omitempty"`
-	Password              string `json:"password,omitempty" yaml:"password,omitempty"`
-	Username              string `json:"username,omitempty" yaml:"username,omitempty"`
-	Token                 string `json:"token,omitempty" yaml:"token,omitempty"`
+	ClientCertificateData string             `json:"client-certificate-data,omitempty" yaml:"client-certificate-data,omitempty"`
+	ClientKeyData         string             `json:"client-key-data,omitempty" yaml:"client-key-data,omitempty"`
+	Password              string             `json:"password,omitempty" yaml:"password,omitempty"`
+	Username              string             `json:"username,omitempty" yaml:"username,omitempty"`
+	Token                 string             `json:"token,omitempty" yaml:"token,omitempty"`
+	Exec                  *KubectlExecConfig `json:"exec,omitempty" yaml:"exec,omitempty"`
+}
+
+// KubectlExecConfig configures an exec-based credential plugin,
+// see https://kubernetes.io/docs/reference/access-authn-authz/authentication/#client-go-credential-plugins
+type KubectlExecConfig struct {
+	ApiVersion         string               `json:"apiVersion" yaml:"apiVersion"`
+	Command            string               `json:"command" yaml:"command"`
+	Args               []string             `json:"args,omitempty" yaml:"args,omitempty"`
+	Env                []*KubectlExecEnvVar `json:"env,omitempty" yaml:"env,omitempty"`
+	InteractiveMode    string               `json:"interactiveMode,omitempty" yaml:"interactiveMode,omitempty"`
+	ProvideClusterInfo bool                 `json:"provideClusterInfo,omitempty" yaml:"provideClusterInfo,omitempty"`
+}
+
+type KubectlExecEnvVar struct {
+	Name  string `json:"name" yaml:"name"`
+	Value string `json:"value" yaml:"value"`
 }
 
 // getKubeconfig call the kubeconfig endpoint to retrieve the kube config file
@@ -72,3 +89,129 @@ func parseKubeconfig(kubeconfigRaw *CloudProjectKubeKubeConfigResponse) (*Kubect
 	kubeconfig.Raw = &kubeconfigRaw.Content
 	return &kubeconfig, nil
 }
+
+// mergeKubeconfigs merges the clusters, users and contexts of several kubeconfig files into one.
+// When names[i] isn't empty, the cluster, user and context of configs[i] are renamed after it.
+// Names clashing with an already merged entry are suffixed with a number.
+func mergeKubeconfigs(configs []*KubectlConfig, names []string) *KubectlConfig {
+	merged := &KubectlConfig{
+		Kind:       "Config",
+		ApiVersion: "v1",
+		Clusters:   make([]*KubectlClusterWithName, 0),
+		Contexts:   make([]*KubectlContextWithName, 0),
+		Users:      make([]*KubectlUserWithName, 0),
+	}
+
+	clusterNames := make(map[string]bool)
+	userNames := make(map[string]bool)
+	contextNames := make(map[string]bool)
+
+	for i, config := range configs {
+		name := ""
+		if i < len(names) {
+			name = names[i]
+		}
+
+		renamedClusters := make(map[string]string)
+		for _, cluster := range config.Clusters {
+			newName := uniqueKubeconfigName(clusterNames, cluster.Name, name)
+			renamedClusters[cluster.Name] = newName
+			merged.Clusters = append(merged.Clusters, &KubectlClusterWithName{Name: newName, Cluster: cluster.Cluster})
+		}
+
+		renamedUsers := make(map[string]string)
+		for _, user := range config.Users {
+			newName := uniqueKubeconfigName(userNames, user.Name, name)
+			renamedUsers[user.Name] = newName
+			merged.Users = append(merged.Users, &KubectlUserWithName{Name: newName, User: user.User})
+		}
+
+		for _, context := range config.Contexts {
+			merged.Contexts = append(merged.Contexts, &KubectlContextWithName{
+				Name: uniqueKubeconfigName(contextNames, context.Name, name),
+				Context: KubectlContext{
+					Cluster: renamedClusters[context.Context.Cluster],
+					User:    renamedUsers[context.Context.User],
+				},
+			})
+		}
+	}
+
+	if len(merged.Contexts) > 0 {
+		merged.CurrentContext = merged.Contexts[0].Name
+	}
+
+	return merged
+}
+
+// uniqueKubeconfigName returns override, or name when override is empty,
+// suffixed with a number if it is already part of used
+func uniqueKubeconfigName(used map[string]bool, name, override string) string {
+	if override != "" {
+		name = override
+	}
+
+	unique := name
+	for i := 2; used[unique]; i++ {
+		unique = fmt.Sprintf("%s-%d", name, i)
+	}
+	used[unique] = true
+
+	return unique
+}
+
+// renderKubeconfig returns the kubeconfig file in YAML format
+func renderKubeconfig(kubeconfig *KubectlConfig) (string, error) {
+	out, err := yaml.Marshal(kubeconfig)
+	if err != nil {
+		return "", err
+	}
+
+	return string(out), nil
+}
+
+// newOIDCKubeconfig returns a kubeconfig file authenticating on the given cluster through OIDC,
+// using the kubelogin credential plugin. When command is kubectl, kubelogin is run as the
+// oidc-login kubectl plugin.
+func newOIDCKubeconfig(name, server, caData, command, issuerUrl, clientId string, extraScopes []string) *KubectlConfig {
+	args := []string{"get-token", "--oidc-issuer-url=" + issuerUrl, "--oidc-client-id=" + clientId}
+	if command == "kubectl" {
+		args = append([]string{"oidc-login"}, args...)
+	}
+	for _, scope := range extraScopes {
+		args = append(args, "--oidc-extra-scope="+scope)
+	}
+
+	userName := "oidc-" + name
+
+	return &KubectlConfig{
+		Kind:           "Config",
+		ApiVersion:     "v1",
+		CurrentContext: name,
+		Clusters: []*KubectlClusterWithName{
+			{
+				Name:    name,
+				Cluster: KubectlCluster{Server: server, CertificateAuthorityData: caData},
+			},
+		},
+		Contexts: []*KubectlContextWithName{
+			{
+				Name:    name,
+				Context: KubectlContext{Cluster: name, User: userName},
+			},
+		},
+		Users: []*KubectlUserWithName{
+			{
+				Name: userName,
+				User: KubectlUser{
+					Exec: &KubectlExecConfig{
+						ApiVersion:      "client.authentication.k8s.io/v1beta1",
+						Command:         command,
+						Args:            args,
+						InteractiveMode: "IfAvailable",
+					},
+				},
+			},
+		},
+	}
+}
@@ -84,3 +84,45 @@ users:
 		})
 	}
 }
+
+func Test_mergeKubeconfigs(t *testing.T) {
+	newConfig := func(name, server string) *KubectlConfig {
+		return &KubectlConfig{
+			Clusters: []*KubectlClusterWithName{{Name: name, Cluster: KubectlCluster{Server: server}}},
+			Contexts: []*KubectlContextWithName{{Name: "kubernetes-admin@" + name, Context: KubectlContext{Cluster: name, User: "kubernetes-admin-" + name}}},
+			Users:    []*KubectlUserWithName{{Name: "kubernetes-admin-" + name, User: KubectlUser{Token: server}}},
+		}
+	}
+
+	got := mergeKubeconfigs(
+		[]*KubectlConfig{newConfig("foo", "https://gra"), newConfig("foo", "https://sbg"), newConfig("bar", "https://bhs")},
+		[]string{"", "", "bhs"},
+	)
+
+	want := &KubectlConfig{
+		Kind:           "Config",
+		ApiVersion:     "v1",
+		CurrentContext: "kubernetes-admin@foo",
+		Clusters: []*KubectlClusterWithName{
+			{Name: "foo", Cluster: KubectlCluster{Server: "https://gra"}},
+			{Name: "foo-2", Cluster: KubectlCluster{Server: "https://sbg"}},
+			{Name: "bhs", Cluster: KubectlCluster{Server: "https://bhs"}},
+		},
+		Contexts: []*KubectlContextWithName{
+			{Name: "kubernetes-admin@foo", Context: KubectlContext{Cluster: "foo", User: "kubernetes-admin-foo"}},
+			{Name: "kubernetes-admin@foo-2", Context: KubectlContext{Cluster: "foo-2", User: "kubernetes-admin-foo-2"}},
+			{Name: "bhs", Context: KubectlContext{Cluster: "bhs", User: "bhs"}},
+		},
+		Users: []*KubectlUserWithName{
+			{Name: "kubernetes-admin-foo", User: KubectlUser{Token: "https://gra"}},
+			{Name: "kubernetes-admin-foo-2", User: KubectlUser{Token: "https://sbg"}},
+			{Name: "bhs", User: KubectlUser{Token: "https://bhs"}},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		gotYaml, _ := renderKubeconfig(got)
+		wantYaml, _ := renderKubeconfig(want)
+		t.Errorf("mergeKubeconfigs() = %s, want %s", gotYaml, wantYaml)
+	}
+}
@@ -0,0 +1,227 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+// cloudProjectKubeIpRestrictionsLocks holds a mutex per cluster. The API only
+// exposes the whole list of IP restrictions, so concurrent read-modify-write
+// cycles on the same cluster would lose updates.
+var cloudProjectKubeIpRestrictionsLocks sync.Map
+
+func lockCloudProjectKubeIpRestrictions(serviceName, kubeId string) func() {
+	lock, _ := cloudProjectKubeIpRestrictionsLocks.LoadOrStore(serviceName+"/"+kubeId, &sync.Mutex{})
+	mutex := lock.(*sync.Mutex)
+	mutex.Lock()
+	return mutex.Unlock
+}
+
+func resourceCloudProjectKubeIpRestriction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudProjectKubeIpRestrictionCreate,
+		Read:   resourceCloudProjectKubeIpRestrictionRead,
+		Delete: resourceCloudProjectKubeIpRestrictionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudProjectKubeIpRestrictionImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "Service name",
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+			},
+			"kube_id": {
+				Type:        schema.TypeString,
+				Description: "Kube ID",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ip": {
+				Type:        schema.TypeString,
+				Description: "CIDR authorized to interact with the cluster",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if err := helpers.ValidateIpBlock(v.(string)); err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+		},
+	}
+}
+
+func resourceCloudProjectKubeIpRestrictionImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("import Id is not service_name/kube_id/ip formatted")
+	}
+	serviceName := splitId[0]
+	kubeId := splitId[1]
+	// the ip is a CIDR, it may contain a "/" too
+	ip := splitId[2]
+	d.SetId(fmt.Sprintf("%s/%s", kubeId, ip))
+	d.Set("service_name", serviceName)
+	d.Set("kube_id", kubeId)
+	d.Set("ip", ip)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceCloudProjectKubeIpRestrictionCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	kubeId := d.Get("kube_id").(string)
+	ip := d.Get("ip").(string)
+
+	unlock := lockCloudProjectKubeIpRestrictions(serviceName, kubeId)
+	defer unlock()
+
+	ips, err := getCloudProjectKubeIpRestrictions(config, serviceName, kubeId)
+	if err != nil {
+		return err
+	}
+
+	// taking over an existing restriction would remove it on destroy while it may be owned elsewhere
+	if indexOfCloudProjectKubeIpRestriction(ips, ip) >= 0 {
+		return fmt.Errorf("IP restriction %s already exists on cluster %s, import it with: terraform import <address> %s/%s/%s",
+			ip, kubeId, serviceName, kubeId, ip)
+	}
+
+	params := &CloudProjectKubeIpRestrictionsCreateOrUpdateOpts{
+		Ips: append(ips, ip),
+	}
+	if err := resourceCloudProjectKubeIpRestrictionsUpdate(d, config, serviceName, kubeId, params, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", kubeId, ip))
+
+	return resourceCloudProjectKubeIpRestrictionRead(d, meta)
+}
+
+func resourceCloudProjectKubeIpRestrictionRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	kubeId := d.Get("kube_id").(string)
+	ip := d.Get("ip").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/ipRestrictions", url.PathEscape(serviceName), url.PathEscape(kubeId))
+	res := make(CloudProjectKubeIpRestrictionsResponse, 0)
+
+	log.Printf("[DEBUG] Will read iprestriction %s from cluster %s in project %s", ip, kubeId, serviceName)
+	if err := config.OVHClient.Get(endpoint, &res); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	if indexOfCloudProjectKubeIpRestriction(res, ip) < 0 {
+		log.Printf("[WARN] IP restriction %s not found on cluster %s, removing it from state", ip, kubeId)
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[DEBUG] Read iprestriction %s", ip)
+	return nil
+}
+
+func resourceCloudProjectKubeIpRestrictionDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	kubeId := d.Get("kube_id").(string)
+	ip := d.Get("ip").(string)
+
+	unlock := lockCloudProjectKubeIpRestrictions(serviceName, kubeId)
+	defer unlock()
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/ipRestrictions", url.PathEscape(serviceName), url.PathEscape(kubeId))
+	ips := make(CloudProjectKubeIpRestrictionsResponse, 0)
+
+	log.Printf("[DEBUG] Will read iprestrictions from cluster %s in project %s", kubeId, serviceName)
+	if err := config.OVHClient.Get(endpoint, &ips); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	if i := indexOfCloudProjectKubeIpRestriction(ips, ip); i >= 0 {
+		if len(ips) == 1 {
+			log.Printf("[WARN] IP restriction %s is the last one of cluster %s, its API server becomes reachable from any IP", ip, kubeId)
+		}
+		params := &CloudProjectKubeIpRestrictionsCreateOrUpdateOpts{
+			Ips: append(ips[:i], ips[i+1:]...),
+		}
+		if err := resourceCloudProjectKubeIpRestrictionsUpdate(d, config, serviceName, kubeId, params, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func getCloudProjectKubeIpRestrictions(config *Config, serviceName, kubeId string) ([]string, error) {
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/ipRestrictions", url.PathEscape(serviceName), url.PathEscape(kubeId))
+	res := make(CloudProjectKubeIpRestrictionsResponse, 0)
+
+	log.Printf("[DEBUG] Will read iprestrictions from cluster %s in project %s", kubeId, serviceName)
+	if err := config.OVHClient.Get(endpoint, &res); err != nil {
+		return nil, fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+
+	return res, nil
+}
+
+// indexOfCloudProjectKubeIpRestriction returns the index of the CIDR ip in ips, comparing them
+// once normalized so that e.g. "10.0.0.1" matches "10.0.0.1/32"
+func indexOfCloudProjectKubeIpRestriction(ips []string, ip string) int {
+	ip = normalizeCloudProjectKubeIpRestriction(ip)
+	for i, v := range ips {
+		if normalizeCloudProjectKubeIpRestriction(v) == ip {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// normalizeCloudProjectKubeIpRestriction returns the network of a CIDR, a single IP being a /32 or a /128.
+// Values which can't be parsed are returned as is.
+func normalizeCloudProjectKubeIpRestriction(ip string) string {
+	if !strings.Contains(ip, "/") {
+		parsed := net.ParseIP(ip)
+		if parsed == nil {
+			return ip
+		}
+		if parsed.To4() != nil {
+			return parsed.String() + "/32"
+		}
+		return parsed.String() + "/128"
+	}
+
+	_, ipNet, err := net.ParseCIDR(ip)
+	if err != nil {
+		return ip
+	}
+	return ipNet.String()
+}
@@ -0,0 +1,132 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var testAccCloudProjectKubeIpRestrictionConfig = `
+resource "ovh_cloud_project_kube" "cluster" {
+	service_name  = "%s"
+	name          = "%s"
+	region        = "%s"
+}
+
+resource "ovh_cloud_project_kube_iprestriction" "platform" {
+	service_name  = ovh_cloud_project_kube.cluster.service_name
+	kube_id       = ovh_cloud_project_kube.cluster.id
+	ip            = "10.42.0.0/16"
+}
+
+resource "ovh_cloud_project_kube_iprestriction" "vpn" {
+	service_name  = ovh_cloud_project_kube.cluster.service_name
+	kube_id       = ovh_cloud_project_kube.cluster.id
+	ip            = "10.43.0.0/16"
+}
+
+data "ovh_cloud_project_kube_iprestrictions" "iprestrictions" {
+	service_name  = ovh_cloud_project_kube.cluster.service_name
+	kube_id       = ovh_cloud_project_kube.cluster.id
+
+	depends_on = [
+		ovh_cloud_project_kube_iprestriction.platform,
+		ovh_cloud_project_kube_iprestriction.vpn,
+	]
+}
+`
+
+func Test_indexOfCloudProjectKubeIpRestriction(t *testing.T) {
+	ips := []string{"10.42.0.0/16", "10.43.0.0/16"}
+
+	if got := indexOfCloudProjectKubeIpRestriction(ips, "10.43.0.0/16"); got != 1 {
+		t.Errorf("indexOfCloudProjectKubeIpRestriction() = %d, want 1", got)
+	}
+	if got := indexOfCloudProjectKubeIpRestriction(ips, "10.44.0.0/16"); got != -1 {
+		t.Errorf("indexOfCloudProjectKubeIpRestriction() = %d, want -1", got)
+	}
+	if got := indexOfCloudProjectKubeIpRestriction([]string{"10.42.0.0/16", "10.0.0.1"}, "10.0.0.1/32"); got != 1 {
+		t.Errorf("indexOfCloudProjectKubeIpRestriction() = %d, want 1", got)
+	}
+}
+
+func Test_normalizeCloudProjectKubeIpRestriction(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want string
+	}{
+		{ip: "10.0.0.1", want: "10.0.0.1/32"},
+		{ip: "10.0.0.1/32", want: "10.0.0.1/32"},
+		{ip: "10.42.0.0/16", want: "10.42.0.0/16"},
+		{ip: "10.42.1.2/16", want: "10.42.0.0/16"},
+		{ip: "2001:DB8::1", want: "2001:db8::1/128"},
+		{ip: "2001:db8::/32", want: "2001:db8::/32"},
+		{ip: "not-an-ip", want: "not-an-ip"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			if got := normalizeCloudProjectKubeIpRestriction(tt.ip); got != tt.want {
+				t.Errorf("normalizeCloudProjectKubeIpRestriction() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccCloudProjectKubeIpRestriction_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	resourceName := "ovh_cloud_project_kube_iprestriction.vpn"
+	config := fmt.Sprintf(
+		testAccCloudProjectKubeIpRestrictionConfig,
+		os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST"),
+		name,
+		os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloud(t)
+			testAccCheckCloudProjectExists(t)
+			testAccPreCheckKubernetes(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube_iprestriction.platform", "ip", "10.42.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "ip", "10.43.0.0/16"),
+					resource.TestCheckResourceAttr("data.ovh_cloud_project_kube_iprestrictions.iprestrictions", "ips.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return fmt.Sprintf(
+						"%s/%s/%s",
+						os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST"),
+						state.RootModule().Resources[resourceName].Primary.Attributes["kube_id"],
+						state.RootModule().Resources[resourceName].Primary.Attributes["ip"],
+					), nil
+				},
+			},
+			{
+				// the restriction is already owned by ovh_cloud_project_kube_iprestriction.vpn
+				Config: config + `
+resource "ovh_cloud_project_kube_iprestriction" "duplicate" {
+	service_name  = ovh_cloud_project_kube.cluster.service_name
+	kube_id       = ovh_cloud_project_kube.cluster.id
+	ip            = "10.43.0.0/16"
+}
+`,
+				ExpectError: regexp.MustCompile("already exists on cluster"),
+			},
+		},
+	})
+}
@@ -100,7 +100,10 @@ func resourceCloudProjectKubeIpRestrictionsCreateOrUpdate(d *schema.ResourceData
 
 	params := (&CloudProjectKubeIpRestrictionsCreateOrUpdateOpts{}).FromResource(d)
 
-	err := resourceCloudProjectKubeIpRestrictionsUpdate(d, config, serviceName, kubeId, params)
+	unlock := lockCloudProjectKubeIpRestrictions(serviceName, kubeId)
+	defer unlock()
+
+	err := resourceCloudProjectKubeIpRestrictionsUpdate(d, config, serviceName, kubeId, params, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return err
 	}
@@ -116,12 +119,15 @@ func resourceCloudProjectKubeIpRestrictionsDelete(d *schema.ResourceData, meta i
 	serviceName := d.Get("service_name").(string)
 	kubeId := d.Get("kube_id").(string)
 
+	unlock := lockCloudProjectKubeIpRestrictions(serviceName, kubeId)
+	defer unlock()
+
 	return resourceCloudProjectKubeIpRestrictionsUpdate(d, config, serviceName, kubeId, &CloudProjectKubeIpRestrictionsCreateOrUpdateOpts{
 		Ips: []string{},
-	})
+	}, d.Timeout(schema.TimeoutDelete))
 }
 
-func resourceCloudProjectKubeIpRestrictionsUpdate(d *schema.ResourceData, config *Config, serviceName string, kubeId string, params *CloudProjectKubeIpRestrictionsCreateOrUpdateOpts) error {
+func resourceCloudProjectKubeIpRestrictionsUpdate(d *schema.ResourceData, config *Config, serviceName string, kubeId string, params *CloudProjectKubeIpRestrictionsCreateOrUpdateOpts, timeout time.Duration) error {
 	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/ipRestrictions", url.PathEscape(serviceName), url.PathEscape(kubeId))
 	res := make(CloudProjectKubeIpRestrictionsResponse, 0)
 
@@ -132,7 +138,7 @@ func resourceCloudProjectKubeIpRestrictionsUpdate(d *schema.ResourceData, config
 	}
 
 	log.Printf("[DEBUG] Waiting for kube %s to be READY", kubeId)
-	err = waitForCloudProjectKubeReady(config.OVHClient, serviceName, kubeId, []string{"REDEPLOYING", "RESETTING"}, []string{"READY"}, d.Timeout(schema.TimeoutUpdate))
+	err = waitForCloudProjectKubeReady(config.OVHClient, serviceName, kubeId, []string{"REDEPLOYING", "RESETTING"}, []string{"READY"}, timeout)
 	if err != nil {
 		return fmt.Errorf("timeout while waiting kube %s to be READY: %v", kubeId, err)
 	}
@@ -1,9 +1,12 @@
 package ovh
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"log"
+	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,6 +16,11 @@ import (
 	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
 )
 
+const kubeNodePoolReplacementStrategyCreateBeforeDestroy = "create_before_destroy_with_drain"
+
+// kubeNodePoolReplacementKeys are the attributes which cannot be updated on an existing nodepool
+var kubeNodePoolReplacementKeys = []string{"flavor_name", "anti_affinity", "monthly_billed"}
+
 func resourceCloudProjectKubeNodePool() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCloudProjectKubeNodePoolCreate,
@@ -24,6 +32,8 @@ func resourceCloudProjectKubeNodePool() *schema.Resource {
 			State: resourceCloudProjectKubeNodePoolImportState,
 		},
 
+		CustomizeDiff: resourceCloudProjectKubeNodePoolCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(time.Hour),
 			Update:  schema.DefaultTimeout(time.Hour),
@@ -76,26 +86,26 @@ func resourceCloudProjectKubeNodePool() *schema.Resource {
 				Description: "Enable anti affinity groups for nodes in the pool",
 				Optional:    true,
 				Computed:    true,
-				ForceNew:    true,
 			},
 			"flavor_name": {
 				Type:        schema.TypeString,
 				Description: "Flavor name",
 				Required:    true,
-				ForceNew:    true,
 			},
 			"desired_nodes": {
-				Type:        schema.TypeInt,
-				Description: "Number of nodes you desire in the pool",
-				Optional:    true,
-				Computed:    true,
+				Type:             schema.TypeInt,
+				Description:      "Number of nodes you desire in the pool",
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: kubeNodePoolDesiredNodesDiffSuppress,
 			},
 			"name": {
-				Type:        schema.TypeString,
-				Description: "NodePool resource name",
-				Optional:    true,
-				Computed:    true,
-				ForceNew:    true,
+				Type:             schema.TypeString,
+				Description:      "NodePool resource name",
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: kubeNodePoolReplacementNameDiffSuppress,
 			},
 			"max_nodes": {
 				Type:        schema.TypeInt,
@@ -114,10 +124,64 @@ func resourceCloudProjectKubeNodePool() *schema.Resource {
 				Description: "Enable monthly billing on all nodes in the pool",
 				Optional:    true,
 				Computed:    true,
+			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Description: "Prevent the nodepool from being deleted",
+				Optional:    true,
+				Default:     false,
+			},
+			"check_quota": {
+				Type:        schema.TypeBool,
+				Description: "Fail the plan, instead of logging a warning, when the nodes to add to the pool don't fit in the quotas left in the region of the cluster",
+				Optional:    true,
+				Default:     false,
+			},
+			"availability_zones": {
+				Type:        schema.TypeSet,
+				Description: "Availability zones in which the nodes of the pool are spread, for the regions with several zones",
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+			"attach_floating_ips": {
+				Type:        schema.TypeList,
+				Description: "Attach a floating IP to each node of the pool, the cluster must use a private network",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Description: "Attach a floating IP to each node of the pool",
+							Required:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
+			"replacement_strategy": {
+				Type:         schema.TypeString,
+				Description:  "How to replace the pool when flavor_name, anti_affinity or monthly_billed change",
+				Optional:     true,
+				ValidateFunc: helpers.ValidateEnum([]string{kubeNodePoolReplacementStrategyCreateBeforeDestroy}),
 			},
 
 			// computed
+			"base_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the first nodepool of the resource, the replacement nodepools are named after it",
+				Computed:    true,
+			},
+			"applied_desired_nodes": {
+				Type:        schema.TypeInt,
+				Description: "Number of nodes last applied from the configuration, to tell them from the ones set by the cluster autoscaler",
+				Computed:    true,
+			},
 			"available_nodes": {
 				Type:        schema.TypeInt,
 				Description: "Number of nodes which are actually ready in the pool",
@@ -133,6 +197,12 @@ func resourceCloudProjectKubeNodePool() *schema.Resource {
 				Description: "Number of nodes present in the pool",
 				Computed:    true,
 			},
+			"nodes_per_availability_zone": {
+				Type:        schema.TypeMap,
+				Description: "Number of nodes of the pool in each of its availability zones",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
 			"flavor": {
 				Type:        schema.TypeString,
 				Description: "Flavor name",
@@ -163,84 +233,98 @@ func resourceCloudProjectKubeNodePool() *schema.Resource {
 				Description: "Last update date",
 				Computed:    true,
 			},
-			"template": {
-				Description: "Node pool template",
-				Optional:    true,
-				Type:        schema.TypeSet,
-				MaxItems:    1,
-				Set:         CustomSchemaSetFunc(),
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"metadata": {
-							Description: "metadata",
-							Required:    true,
-							Type:        schema.TypeSet,
-							MaxItems:    1,
-							Set:         CustomSchemaSetFunc(),
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"finalizers": {
-										Description: "finalizers",
-										Required:    true,
-										Type:        schema.TypeList,
-										Elem:        &schema.Schema{Type: schema.TypeString},
-									},
-									"labels": {
-										Description: "labels",
-										Required:    true,
-										Type:        schema.TypeMap,
-										Elem:        &schema.Schema{Type: schema.TypeString},
-										Set:         schema.HashString,
-									},
-									"annotations": {
-										Description: "annotations",
-										Required:    true,
-										Type:        schema.TypeMap,
-										Elem:        &schema.Schema{Type: schema.TypeString},
-										Set:         schema.HashString,
-									},
-								},
+			"template": kubeNodePoolTemplateSchema(false),
+		},
+	}
+}
+
+// kubeNodePoolTemplateSchema returns the schema of a nodepool template.
+// forceNew is set on every attribute when the template can't be updated in place.
+func kubeNodePoolTemplateSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Description: "Node pool template",
+		Optional:    true,
+		Type:        schema.TypeList,
+		ForceNew:    forceNew,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"metadata": {
+					Description: "metadata",
+					Optional:    true,
+					Computed:    true,
+					Type:        schema.TypeList,
+					ForceNew:    forceNew,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"finalizers": {
+								Description: "finalizers",
+								Optional:    true,
+								Type:        schema.TypeList,
+								ForceNew:    forceNew,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+							"labels": {
+								Description: "labels",
+								Optional:    true,
+								Type:        schema.TypeMap,
+								ForceNew:    forceNew,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+							"annotations": {
+								Description: "annotations",
+								Optional:    true,
+								Type:        schema.TypeMap,
+								ForceNew:    forceNew,
+								Elem:        &schema.Schema{Type: schema.TypeString},
 							},
 						},
-						"spec": {
-							Description: "spec",
-							Required:    true,
-							Type:        schema.TypeSet,
-							MaxItems:    1,
-							Set:         CustomSchemaSetFunc(),
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"unschedulable": {
-										Description: "unschedulable",
-										Required:    true,
-										Type:        schema.TypeBool,
-									},
-									"taints": {
-										Description: "taints",
-										Required:    true,
-										Type:        schema.TypeList,
-										Elem: &schema.Schema{
-											Type: schema.TypeMap,
-											Set:  schema.HashString,
-											ValidateFunc: func(taintInterface interface{}, path string) (warning []string, errorList []error) {
-												taint := taintInterface.(map[string]interface{})
-
-												if taint["key"] == nil {
-													return nil, []error{errors.New(fmt.Sprintf("key attribute is mandatory for taint: %s", path))}
-												}
-
-												if taint["effect"] == nil {
-													return nil, []error{errors.New(fmt.Sprintf("effect attribute is mandatory for taint: %s", path))}
-												}
-
-												effectString := taint["effect"].(string)
-												effect := TaintEffecTypeToID[effectString]
-												if effect == NotATaint {
-													return nil, []error{fmt.Errorf("effect: %s is not a allowable taint %#v", effectString, TaintEffecTypeToID)}
-												}
-
-												return nil, nil
-											},
+					},
+				},
+				"spec": {
+					Description: "spec",
+					Optional:    true,
+					Computed:    true,
+					Type:        schema.TypeList,
+					ForceNew:    forceNew,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"unschedulable": {
+								Description: "unschedulable",
+								Optional:    true,
+								Default:     false,
+								Type:        schema.TypeBool,
+								ForceNew:    forceNew,
+							},
+							"taints": {
+								Description: "taints",
+								Optional:    true,
+								Type:        schema.TypeList,
+								ForceNew:    forceNew,
+								// keep the taints = [{ ... }] syntax of the former list of maps
+								ConfigMode: schema.SchemaConfigModeAttr,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"key": {
+											Description: "Taint key",
+											Required:    true,
+											Type:        schema.TypeString,
+											ForceNew:    forceNew,
+										},
+										"value": {
+											Description: "Taint value",
+											Optional:    true,
+											Type:        schema.TypeString,
+											ForceNew:    forceNew,
+										},
+										"effect": {
+											Description:  "Taint effect",
+											Required:     true,
+											Type:         schema.TypeString,
+											ForceNew:     forceNew,
+											ValidateFunc: helpers.ValidateEnum([]string{NoExecute.String(), NoSchedule.String(), PreferNoSchedule.String()}),
 										},
 									},
 								},
@@ -265,6 +349,8 @@ func resourceCloudProjectKubeNodePoolImportState(d *schema.ResourceData, meta in
 	d.SetId(id)
 	d.Set("kube_id", kubeId)
 	d.Set("service_name", serviceName)
+	d.Set("deletion_protection", false)
+	d.Set("check_quota", false)
 
 	results := make([]*schema.ResourceData, 1)
 	results[0] = d
@@ -281,6 +367,16 @@ func resourceCloudProjectKubeNodePoolCreate(d *schema.ResourceData, meta interfa
 	if err != nil {
 		return err
 	}
+	if len(params.AvailabilityZones) > 0 {
+		if err := checkCloudProjectKubeNodePoolAvailabilityZones(config.OVHClient, serviceName, kubeId, params.AvailabilityZones); err != nil {
+			return err
+		}
+	}
+	if params.AttachFloatingIps != nil && params.AttachFloatingIps.Enabled {
+		if err := checkCloudProjectKubeNodePoolFloatingIps(config.OVHClient, serviceName, kubeId); err != nil {
+			return err
+		}
+	}
 	res := &CloudProjectKubeNodePoolResponse{}
 
 	log.Printf("[DEBUG] Will create nodepool: %+v", params)
@@ -305,6 +401,7 @@ func resourceCloudProjectKubeNodePoolCreate(d *schema.ResourceData, meta interfa
 	log.Printf("[DEBUG] nodepool %s is READY", res.Id)
 
 	d.SetId(res.Id)
+	d.Set("applied_desired_nodes", d.Get("desired_nodes"))
 
 	return resourceCloudProjectKubeNodePoolRead(d, meta)
 }
@@ -322,6 +419,12 @@ func resourceCloudProjectKubeNodePoolRead(d *schema.ResourceData, meta interface
 		return helpers.CheckDeleted(d, err, endpoint)
 	}
 
+	// Only keep the labels known from the configuration, the other ones are added by the API.
+	// When importing, the state is still empty so every label is kept.
+	if res.Template != nil && d.Get("flavor_name").(string) != "" {
+		res.Template.Metadata.Labels = filterNodePoolTemplateLabels(res.Template.Metadata.Labels, d.Get("template.0.metadata.0.labels").(map[string]interface{}))
+	}
+
 	for k, v := range res.ToMap() {
 		if k != "id" {
 			d.Set(k, v)
@@ -330,6 +433,22 @@ func resourceCloudProjectKubeNodePoolRead(d *schema.ResourceData, meta interface
 		}
 	}
 
+	nodesPerZone, err := getCloudProjectKubeNodePoolNodesPerAvailabilityZone(config, serviceName, kubeId, res)
+	if err != nil {
+		return err
+	}
+	d.Set("nodes_per_availability_zone", nodesPerZone)
+
+	// A replacement nodepool keeps the base name of the one it replaced
+	if d.Get("base_name").(string) == "" {
+		d.Set("base_name", res.Name)
+	}
+
+	// When importing, the current size is taken as the last applied one
+	if _, ok := d.GetOkExists("applied_desired_nodes"); !ok {
+		d.Set("applied_desired_nodes", res.DesiredNodes)
+	}
+
 	log.Printf("[DEBUG] Read nodepool: %+v", res)
 	return nil
 }
@@ -339,6 +458,16 @@ func resourceCloudProjectKubeNodePoolUpdate(d *schema.ResourceData, meta interfa
 	serviceName := d.Get("service_name").(string)
 	kubeId := d.Get("kube_id").(string)
 
+	// The CustomizeDiff only lets these changes through when using the create_before_destroy_with_drain strategy
+	if d.HasChanges(kubeNodePoolReplacementKeys...) {
+		return resourceCloudProjectKubeNodePoolReplace(d, meta)
+	}
+
+	// These attributes are only used by the provider
+	if !d.HasChangesExcept("deletion_protection", "replacement_strategy", "check_quota") {
+		return resourceCloudProjectKubeNodePoolRead(d, meta)
+	}
+
 	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool/%s", serviceName, kubeId, d.Id())
 	params, err := (&CloudProjectKubeNodePoolUpdateOpts{}).FromResource(d)
 	if err != nil {
@@ -350,6 +479,9 @@ func resourceCloudProjectKubeNodePoolUpdate(d *schema.ResourceData, meta interfa
 	if err != nil {
 		return fmt.Errorf("calling Put %s with params %v:\n\t %w", endpoint, *params, err)
 	}
+	if d.HasChange("desired_nodes") {
+		d.Set("applied_desired_nodes", d.Get("desired_nodes"))
+	}
 
 	log.Printf("[DEBUG] Waiting for nodepool %s to be READY", d.Id())
 	err = waitForCloudProjectKubeNodePoolWithStateTarget(config.OVHClient, serviceName, kubeId, d.Id(), d.Timeout(schema.TimeoutUpdate), []string{"READY"})
@@ -366,6 +498,10 @@ func resourceCloudProjectKubeNodePoolDelete(d *schema.ResourceData, meta interfa
 	serviceName := d.Get("service_name").(string)
 	kubeId := d.Get("kube_id").(string)
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("nodepool %s of cluster %s can't be deleted while deletion_protection is set to true", d.Id(), kubeId)
+	}
+
 	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool/%s", serviceName, kubeId, d.Id())
 
 	log.Printf("[DEBUG] Will delete nodepool %s from cluster %s in project %s", d.Id(), kubeId, serviceName)
@@ -386,6 +522,316 @@ func resourceCloudProjectKubeNodePoolDelete(d *schema.ResourceData, meta interfa
 	return nil
 }
 
+func resourceCloudProjectKubeNodePoolCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateKubeNodePoolSize(
+		kubeNodePoolSizeFromDiff(d, "min_nodes"),
+		// the desired nodes in the state may come from the cluster autoscaler, only check the configured ones
+		kubeNodePoolSizeFromConfig(d, "desired_nodes"),
+		kubeNodePoolSizeFromDiff(d, "max_nodes"),
+	); err != nil {
+		return err
+	}
+
+	if err := reportCloudProjectQuota(d, checkCloudProjectKubeNodePoolQuota(d, meta.(*Config))); err != nil {
+		return err
+	}
+
+	if d.Id() == "" || d.Get("replacement_strategy").(string) == kubeNodePoolReplacementStrategyCreateBeforeDestroy {
+		return nil
+	}
+
+	for _, key := range kubeNodePoolReplacementKeys {
+		if d.HasChange(key) {
+			if err := d.ForceNew(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkCloudProjectKubeNodePoolQuota checks the nodes to add to the pool fit in the quotas
+// left in the region of the cluster. An autoscaled pool may grow up to max_nodes.
+func checkCloudProjectKubeNodePoolQuota(d *schema.ResourceDiff, config *Config) error {
+	if d.Id() != "" && !d.HasChanges("desired_nodes", "max_nodes", "autoscale", "flavor_name") {
+		return nil
+	}
+	if !d.NewValueKnown("service_name") || !d.NewValueKnown("kube_id") || !d.NewValueKnown("flavor_name") || !d.NewValueKnown("autoscale") {
+		return nil
+	}
+	size := kubeNodePoolSizeFromDiff(d, "desired_nodes")
+	if d.Get("autoscale").(bool) {
+		size = kubeNodePoolSizeFromDiff(d, "max_nodes")
+	}
+	if size == nil {
+		return nil
+	}
+
+	// the current nodes are already counted in the quotas,
+	// but a new flavor replaces all of them and they may coexist with the new ones
+	nodes := *size
+	if d.Id() != "" && !d.HasChange("flavor_name") {
+		old, _ := d.GetChange("desired_nodes")
+		nodes -= old.(int)
+	}
+	if nodes <= 0 {
+		return nil
+	}
+
+	serviceName := d.Get("service_name").(string)
+	kubeId := d.Get("kube_id").(string)
+	flavorName := d.Get("flavor_name").(string)
+
+	kube := &CloudProjectKubeResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s", url.PathEscape(serviceName), url.PathEscape(kubeId))
+	if err := config.OVHClient.Get(endpoint, kube); err != nil {
+		return fmt.Errorf("unable to check the quotas of project %s: calling Get %s:\n\t %w", serviceName, endpoint, err)
+	}
+
+	var flavors []CloudProjectKubeFlavor
+	endpoint = fmt.Sprintf("/cloud/project/%s/capabilities/kube/flavors?region=%s", url.PathEscape(serviceName), url.QueryEscape(kube.Region))
+	if err := config.OVHClient.Get(endpoint, &flavors); err != nil {
+		return fmt.Errorf("unable to check the quotas of project %s: calling Get %s:\n\t %w", serviceName, endpoint, err)
+	}
+
+	i := slices.IndexFunc(flavors, func(f CloudProjectKubeFlavor) bool { return f.Name == flavorName })
+	if i < 0 {
+		return fmt.Errorf("unable to check the quotas of project %s: unknown flavor %s in region %s", serviceName, flavorName, kube.Region)
+	}
+
+	// the RAM of the kube flavors is in GB
+	return checkCloudProjectQuota(config.OVHClient, serviceName, kube.Region, CloudProjectQuotaRequest{
+		Instances: nodes,
+		Cores:     nodes * flavors[i].VCPUs,
+		Ram:       nodes * flavors[i].RAM * 1024,
+	})
+}
+
+// kubeNodePoolDesiredNodesDiffSuppress ignores the desired nodes set by the cluster autoscaler on an existing
+// autoscaled pool, as long as the configuration keeps the last applied value
+func kubeNodePoolDesiredNodesDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && d.Get("autoscale").(bool) && new == strconv.Itoa(d.Get("applied_desired_nodes").(int))
+}
+
+// kubeNodePoolSizeFromConfig returns the configured value of a node count.
+// It returns nil when the value is unknown or isn't configured.
+func kubeNodePoolSizeFromConfig(d *schema.ResourceDiff, key string) *int {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() {
+		return nil
+	}
+	v := raw.GetAttr(key)
+	if !v.IsKnown() || v.IsNull() {
+		return nil
+	}
+	size, _ := v.AsBigFloat().Int64()
+	res := int(size)
+	return &res
+}
+
+// kubeNodePoolSizeFromDiff returns the configured value of a node count, or the one from the state
+// when it isn't configured. It returns nil when the value is unknown or not set yet.
+func kubeNodePoolSizeFromDiff(d *schema.ResourceDiff, key string) *int {
+	raw := d.GetRawConfig()
+	if !raw.IsNull() && raw.IsKnown() {
+		v := raw.GetAttr(key)
+		if !v.IsKnown() {
+			return nil
+		}
+		if !v.IsNull() {
+			return kubeNodePoolSizeFromConfig(d, key)
+		}
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+	res := d.Get(key).(int)
+	return &res
+}
+
+// validateKubeNodePoolSize checks that min <= desired <= max, ignoring the unset values
+func validateKubeNodePoolSize(min, desired, max *int) error {
+	if min != nil && max != nil && *min > *max {
+		return fmt.Errorf("min_nodes (%d) must be lower than or equal to max_nodes (%d)", *min, *max)
+	}
+	if desired == nil {
+		return nil
+	}
+	if min != nil && *desired < *min {
+		return fmt.Errorf("desired_nodes (%d) must be greater than or equal to min_nodes (%d)", *desired, *min)
+	}
+	if max != nil && *desired > *max {
+		return fmt.Errorf("desired_nodes (%d) must be lower than or equal to max_nodes (%d)", *desired, *max)
+	}
+	return nil
+}
+
+// kubeNodePoolReplacementNameDiffSuppress ignores the name given to a replacement nodepool
+// as long as the configuration keeps the name of the first nodepool of the resource
+func kubeNodePoolReplacementNameDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	return new != "" && new == d.Get("base_name").(string)
+}
+
+// kubeNodePoolReplacementName returns the name of a nodepool replacing the pools named after base
+func kubeNodePoolReplacementName(base string, now time.Time) string {
+	return fmt.Sprintf("%s-r%s", base, strconv.FormatInt(now.Unix(), 36))
+}
+
+// resourceCloudProjectKubeNodePoolReplace creates a new nodepool with the updated configuration,
+// waits for all its nodes to be available, then deletes the current nodepool.
+// Deleting the nodepool drains its nodes, so workloads move to the new pool.
+// The new nodepool is deleted when it doesn't become available, the state keeping the current one.
+func resourceCloudProjectKubeNodePoolReplace(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	kubeId := d.Get("kube_id").(string)
+	oldId := d.Id()
+
+	// Keep the prior state on error until the new nodepool is READY
+	d.Partial(true)
+
+	params, err := (&CloudProjectKubeNodePoolCreateOpts{}).FromResource(d)
+	if err != nil {
+		return err
+	}
+	name := kubeNodePoolReplacementName(d.Get("base_name").(string), time.Now())
+	params.Name = &name
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool", serviceName, kubeId)
+	res := &CloudProjectKubeNodePoolResponse{}
+
+	log.Printf("[DEBUG] Will create nodepool %s to replace nodepool %s: %+v", name, oldId, params)
+	if err := config.OVHClient.Post(endpoint, params, res); err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %w", endpoint, params, err)
+	}
+
+	endpoint = fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool/%s", serviceName, kubeId, res.Id)
+	err = helpers.WaitAvailable(config.OVHClient, endpoint, 2*time.Minute)
+	if err == nil {
+		log.Printf("[DEBUG] Waiting for nodepool %s to be READY with all its nodes available", res.Id)
+		err = waitForCloudProjectKubeNodePoolNodesAvailable(config.OVHClient, serviceName, kubeId, res.Id, d.Timeout(schema.TimeoutUpdate))
+	}
+	if err != nil {
+		log.Printf("[DEBUG] Will delete nodepool %s which didn't become READY", res.Id)
+		if errDelete := config.OVHClient.Delete(endpoint, nil); errDelete != nil {
+			return fmt.Errorf("nodepool %s didn't become READY and couldn't be deleted, delete it manually, nodepool %s was kept: %w\n\t calling Delete %s:\n\t %s",
+				res.Id, oldId, err, endpoint, errDelete)
+		}
+		return fmt.Errorf("timeout while waiting nodepool %s to be READY, it has been deleted and nodepool %s was kept: %w", res.Id, oldId, err)
+	}
+	log.Printf("[DEBUG] nodepool %s is READY", res.Id)
+
+	// From now on the resource is the new nodepool
+	d.SetId(res.Id)
+	d.Set("applied_desired_nodes", d.Get("desired_nodes"))
+	d.Partial(false)
+
+	endpoint = fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool/%s", serviceName, kubeId, oldId)
+	log.Printf("[DEBUG] Will delete replaced nodepool %s from cluster %s in project %s", oldId, kubeId, serviceName)
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("calling Delete %s:\n\t %w", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for nodepool %s to be DELETED", oldId)
+	if err := waitForCloudProjectKubeNodePoolDeleted(config.OVHClient, serviceName, kubeId, oldId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("timeout while waiting nodepool %s to be DELETED: %w", oldId, err)
+	}
+	log.Printf("[DEBUG] nodepool %s is DELETED", oldId)
+
+	return resourceCloudProjectKubeNodePoolRead(d, meta)
+}
+
+// checkCloudProjectKubeNodePoolAvailabilityZones checks that the zones exist in the region of the cluster
+func checkCloudProjectKubeNodePoolAvailabilityZones(client *ovh.Client, serviceName, kubeId string, zones []string) error {
+	kube := &CloudProjectKubeResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s", url.PathEscape(serviceName), url.PathEscape(kubeId))
+	if err := client.Get(endpoint, kube); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+
+	region, err := getCloudProjectRegion(serviceName, kube.Region, client)
+	if err != nil {
+		return err
+	}
+
+	return validateKubeNodePoolAvailabilityZones(zones, region.AvailabilityZones, kube.Region)
+}
+
+func validateKubeNodePoolAvailabilityZones(zones, regionZones []string, region string) error {
+	if len(regionZones) == 0 {
+		return fmt.Errorf("region %s has no availability zones, availability_zones can't be set", region)
+	}
+	for _, zone := range zones {
+		if !slices.Contains(regionZones, zone) {
+			return fmt.Errorf("availability zone %s doesn't exist in region %s, expected one of: %s", zone, region, strings.Join(regionZones, ", "))
+		}
+	}
+	return nil
+}
+
+// checkCloudProjectKubeNodePoolFloatingIps checks that the cluster uses a private network, the floating IPs
+// being routed to the nodes through the gateway of this network
+func checkCloudProjectKubeNodePoolFloatingIps(client *ovh.Client, serviceName, kubeId string) error {
+	kube := &CloudProjectKubeResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s", url.PathEscape(serviceName), url.PathEscape(kubeId))
+	if err := client.Get(endpoint, kube); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+
+	if kube.PrivateNetworkId == "" {
+		return fmt.Errorf("floating IPs can only be attached to the nodes of a cluster using a private network, cluster %s has none", kubeId)
+	}
+	return nil
+}
+
+// getCloudProjectKubeNodePoolNodesPerAvailabilityZone counts the nodes of a pool in each of its zones
+// using their underlying instance. It returns nil for the pools which aren't spread across zones.
+func getCloudProjectKubeNodePoolNodesPerAvailabilityZone(config *Config, serviceName, kubeId string, nodePool *CloudProjectKubeNodePoolResponse) (map[string]interface{}, error) {
+	if len(nodePool.AvailabilityZones) == 0 {
+		return nil, nil
+	}
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool/%s/nodes",
+		url.PathEscape(serviceName),
+		url.PathEscape(kubeId),
+		url.PathEscape(nodePool.Id))
+	var nodes []CloudProjectKubeNodeResponse
+	if err := config.OVHClient.Get(endpoint, &nodes); err != nil {
+		return nil, fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+
+	nodeZones := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		instance, err := getCloudProjectKubeNodeInstance(config, serviceName, node.InstanceId)
+		if err != nil {
+			return nil, err
+		}
+		if instance != nil {
+			nodeZones = append(nodeZones, instance.AvailabilityZone)
+		}
+	}
+
+	return countKubeNodesPerAvailabilityZone(nodePool.AvailabilityZones, nodeZones), nil
+}
+
+// countKubeNodesPerAvailabilityZone returns the number of nodes in each zone of the pool, including the empty ones
+func countKubeNodesPerAvailabilityZone(zones, nodeZones []string) map[string]interface{} {
+	counts := make(map[string]interface{}, len(zones))
+	for _, zone := range zones {
+		counts[zone] = 0
+	}
+	for _, zone := range nodeZones {
+		if zone == "" {
+			continue
+		}
+		count, _ := counts[zone].(int)
+		counts[zone] = count + 1
+	}
+	return counts
+}
+
 func cloudProjectKubeNodePoolExists(serviceName, kubeId, id string, client *ovh.Client) error {
 	res := &CloudProjectKubeNodePoolResponse{}
 
@@ -416,6 +862,33 @@ func waitForCloudProjectKubeNodePoolWithStateTarget(client *ovh.Client, serviceN
 	return err
 }
 
+func waitForCloudProjectKubeNodePoolNodesAvailable(client *ovh.Client, serviceName, kubeId, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"INSTALLING", "UPDATING", "REDEPLOYING", "RESIZING", "DOWNSCALING", "UPSCALING", "WAITING_NODES"},
+		Target:  []string{"READY"},
+		Refresh: func() (interface{}, string, error) {
+			res := &CloudProjectKubeNodePoolResponse{}
+			endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool/%s", serviceName, kubeId, id)
+			err := client.Get(endpoint, res)
+			if err != nil {
+				return res, "", err
+			}
+
+			if res.Status == "READY" && res.AvailableNodes < res.DesiredNodes {
+				return res, "WAITING_NODES", nil
+			}
+
+			return res, res.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
 func waitForCloudProjectKubeNodePoolDeleted(client *ovh.Client, serviceName, kubeId, id string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"DELETING"},
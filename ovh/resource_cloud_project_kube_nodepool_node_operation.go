@@ -0,0 +1,339 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+const (
+	kubeNodeOperationReplace = "replace"
+	kubeNodeOperationRemove  = "remove"
+)
+
+func resourceCloudProjectKubeNodePoolNodeOperation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudProjectKubeNodePoolNodeOperationCreate,
+		Read:   resourceCloudProjectKubeNodePoolNodeOperationRead,
+		Delete: resourceCloudProjectKubeNodePoolNodeOperationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "Service name",
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+			},
+			"kube_id": {
+				Type:        schema.TypeString,
+				Description: "Kube ID",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"nodepool_id": {
+				Type:        schema.TypeString,
+				Description: "NodePool ID",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"node_name": {
+				Type:         schema.TypeString,
+				Description:  "Name of the node to operate on",
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"node_name", "instance_id"},
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Description:  "Public Cloud instance ID of the node to operate on",
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"node_name", "instance_id"},
+			},
+			"operation": {
+				Type:         schema.TypeString,
+				Description:  "Operation to run on the node: replace or remove",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      kubeNodeOperationReplace,
+				ValidateFunc: helpers.ValidateEnum([]string{kubeNodeOperationReplace, kubeNodeOperationRemove}),
+			},
+			"keepers": {
+				Type:        schema.TypeList,
+				Description: "Change this value to run the operation again",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			// Computed
+			"node_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the node the operation was run on",
+				Computed:    true,
+			},
+			"desired_nodes": {
+				Type:        schema.TypeInt,
+				Description: "Number of nodes desired in the pool once the operation is done",
+				Computed:    true,
+			},
+			"replacement_node": {
+				Type:        schema.TypeList,
+				Description: "Node created to replace the removed one",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"created_at": {
+							Type:        schema.TypeString,
+							Description: "Creation date",
+							Computed:    true,
+						},
+						"deployed_at": {
+							Type:        schema.TypeString,
+							Description: "Node deployment date",
+							Computed:    true,
+						},
+						"flavor": {
+							Type:        schema.TypeString,
+							Description: "Flavor name",
+							Computed:    true,
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Description: "Node ID",
+							Computed:    true,
+						},
+						"instance_id": {
+							Type:        schema.TypeString,
+							Description: "Public Cloud instance ID",
+							Computed:    true,
+						},
+						"is_up_to_date": {
+							Type:        schema.TypeBool,
+							Description: "True if the node is up to date",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Node name",
+							Computed:    true,
+						},
+						"node_pool_id": {
+							Type:        schema.TypeString,
+							Description: "NodePool parent ID",
+							Computed:    true,
+						},
+						"project_id": {
+							Type:        schema.TypeString,
+							Description: "Project ID",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "Current status",
+							Computed:    true,
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Description: "Last update date",
+							Computed:    true,
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Description: "Node version",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCloudProjectKubeNodePoolNodeOperationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	kubeId := d.Get("kube_id").(string)
+	nodePoolId := d.Get("nodepool_id").(string)
+	operation := d.Get("operation").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(kubeId),
+		url.PathEscape(nodePoolId))
+	nodePool := &CloudProjectKubeNodePoolResponse{}
+
+	log.Printf("[DEBUG] Will read nodepool %s from cluster %s in project %s", nodePoolId, kubeId, serviceName)
+	if err := config.OVHClient.Get(endpoint, nodePool); err != nil {
+		return fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+
+	nodes, err := getCloudProjectKubeNodePoolNodes(config.OVHClient, serviceName, kubeId, nodePoolId)
+	if err != nil {
+		return err
+	}
+
+	target := findCloudProjectKubeNode(nodes, d.Get("node_name").(string), d.Get("instance_id").(string))
+	if target == nil {
+		return fmt.Errorf("node %s%s cannot be found in nodepool %s of cluster %s", d.Get("node_name").(string), d.Get("instance_id").(string), nodePoolId, kubeId)
+	}
+
+	desiredNodes := nodePool.DesiredNodes
+	if operation == kubeNodeOperationRemove {
+		desiredNodes--
+		if !nodePool.Autoscale {
+			log.Printf("[WARN] Removing node %s lowers the desired nodes of nodepool %s to %d, set them in its configuration too or the next apply scales it back up",
+				target.Name, nodePoolId, desiredNodes)
+		}
+	}
+
+	params := &CloudProjectKubeNodePoolUpdateOpts{
+		DesiredNodes:  &desiredNodes,
+		NodesToRemove: []string{target.Id},
+	}
+
+	log.Printf("[DEBUG] Will %s node %s (%s) of nodepool %s: %+v", operation, target.Name, target.Id, nodePoolId, *params)
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %+v:\n\t %w", endpoint, *params, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for nodepool %s to be READY with %d nodes", nodePoolId, desiredNodes)
+	newNodes, err := waitForCloudProjectKubeNodePoolNodes(config.OVHClient, serviceName, kubeId, nodePoolId, target.Id, desiredNodes, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("timeout while waiting nodepool %s to be READY with %d nodes: %w", nodePoolId, desiredNodes, err)
+	}
+	log.Printf("[DEBUG] nodepool %s is READY", nodePoolId)
+
+	replacementNodes := make([]map[string]interface{}, 0)
+	if operation == kubeNodeOperationReplace {
+		for _, node := range newCloudProjectKubeNodes(nodes, newNodes) {
+			replacementNodes = append(replacementNodes, node.ToMap())
+		}
+	}
+
+	d.SetId(target.Id)
+	d.Set("node_id", target.Id)
+	d.Set("desired_nodes", desiredNodes)
+	d.Set("replacement_node", replacementNodes)
+
+	return nil
+}
+
+func resourceCloudProjectKubeNodePoolNodeOperationRead(d *schema.ResourceData, meta interface{}) error {
+	// Nothing to do on READ
+	//
+	// IMPORTANT: This resource doesn't represent a real resource but an operation
+	// on a nodepool. The targeted node no longer exists once the operation is done,
+	// so reading it would make terraform plan the operation again.
+
+	return nil
+}
+
+func resourceCloudProjectKubeNodePoolNodeOperationDelete(d *schema.ResourceData, meta interface{}) error {
+	// the operation can't be reverted, just forget about its Id
+	d.SetId("")
+	return nil
+}
+
+func getCloudProjectKubeNodePoolNodes(client *ovh.Client, serviceName, kubeId, nodePoolId string) ([]CloudProjectKubeNodeResponse, error) {
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool/%s/nodes",
+		url.PathEscape(serviceName),
+		url.PathEscape(kubeId),
+		url.PathEscape(nodePoolId))
+	var nodes []CloudProjectKubeNodeResponse
+
+	if err := client.Get(endpoint, &nodes); err != nil {
+		return nil, fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+
+	return nodes, nil
+}
+
+// findCloudProjectKubeNode returns the node matching the given name or instance ID
+func findCloudProjectKubeNode(nodes []CloudProjectKubeNodeResponse, name, instanceId string) *CloudProjectKubeNodeResponse {
+	for i := range nodes {
+		if (name != "" && nodes[i].Name == name) || (instanceId != "" && nodes[i].InstanceId == instanceId) {
+			return &nodes[i]
+		}
+	}
+
+	return nil
+}
+
+// newCloudProjectKubeNodes returns the nodes of after which were not part of before
+func newCloudProjectKubeNodes(before, after []CloudProjectKubeNodeResponse) []CloudProjectKubeNodeResponse {
+	known := make(map[string]bool, len(before))
+	for _, node := range before {
+		known[node.Id] = true
+	}
+
+	added := make([]CloudProjectKubeNodeResponse, 0)
+	for _, node := range after {
+		if !known[node.Id] {
+			added = append(added, node)
+		}
+	}
+
+	return added
+}
+
+func waitForCloudProjectKubeNodePoolNodes(client *ovh.Client, serviceName, kubeId, nodePoolId, removedNodeId string, desiredNodes int, timeout time.Duration) ([]CloudProjectKubeNodeResponse, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"READY"},
+		Refresh: func() (interface{}, string, error) {
+			nodePool := &CloudProjectKubeNodePoolResponse{}
+			endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool/%s",
+				url.PathEscape(serviceName),
+				url.PathEscape(kubeId),
+				url.PathEscape(nodePoolId))
+			if err := client.Get(endpoint, nodePool); err != nil {
+				return nil, "", err
+			}
+
+			if nodePool.Status == "ERROR" {
+				return nil, "", fmt.Errorf("nodepool %s is in ERROR", nodePoolId)
+			}
+
+			nodes, err := getCloudProjectKubeNodePoolNodes(client, serviceName, kubeId, nodePoolId)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if nodePool.Status != "READY" || nodePool.AvailableNodes != desiredNodes || len(nodes) != desiredNodes {
+				return nodes, "PENDING", nil
+			}
+
+			for _, node := range nodes {
+				if node.Id == removedNodeId || node.Status != "READY" {
+					return nodes, "PENDING", nil
+				}
+			}
+
+			return nodes, "READY", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	res, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, err
+	}
+
+	return res.([]CloudProjectKubeNodeResponse), nil
+}
@@ -0,0 +1,116 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_findCloudProjectKubeNode(t *testing.T) {
+	nodes := []CloudProjectKubeNodeResponse{
+		{Id: "n1", Name: "pool-node-1", InstanceId: "i1"},
+		{Id: "n2", Name: "pool-node-2", InstanceId: "i2"},
+	}
+
+	tests := []struct {
+		name       string
+		nodeName   string
+		instanceId string
+		want       string
+	}{
+		{name: "by name", nodeName: "pool-node-2", want: "n2"},
+		{name: "by instance id", instanceId: "i1", want: "n1"},
+		{name: "not found", nodeName: "pool-node-3", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findCloudProjectKubeNode(nodes, tt.nodeName, tt.instanceId)
+			if tt.want == "" {
+				if got != nil {
+					t.Errorf("findCloudProjectKubeNode() = %v, want nil", got)
+				}
+				return
+			}
+			if got == nil || got.Id != tt.want {
+				t.Errorf("findCloudProjectKubeNode() = %v, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_newCloudProjectKubeNodes(t *testing.T) {
+	before := []CloudProjectKubeNodeResponse{{Id: "n1"}, {Id: "n2"}}
+	after := []CloudProjectKubeNodeResponse{{Id: "n2"}, {Id: "n3"}}
+
+	want := []CloudProjectKubeNodeResponse{{Id: "n3"}}
+	if got := newCloudProjectKubeNodes(before, after); !reflect.DeepEqual(got, want) {
+		t.Errorf("newCloudProjectKubeNodes() = %v, want %v", got, want)
+	}
+}
+
+var testAccCloudProjectKubeNodePoolNodeOperationConfig = `
+resource "ovh_cloud_project_kube" "cluster" {
+  service_name = "%s"
+  name         = "%s"
+  region       = "%s"
+}
+
+resource "ovh_cloud_project_kube_nodepool" "pool" {
+  service_name  = ovh_cloud_project_kube.cluster.service_name
+  kube_id       = ovh_cloud_project_kube.cluster.id
+  name          = ovh_cloud_project_kube.cluster.name
+  flavor_name   = "b2-7"
+  desired_nodes = 1
+  min_nodes     = 0
+  max_nodes     = 2
+}
+
+data "ovh_cloud_project_kube_nodepool_nodes" "nodes" {
+  service_name = ovh_cloud_project_kube.cluster.service_name
+  kube_id      = ovh_cloud_project_kube.cluster.id
+  name         = ovh_cloud_project_kube_nodepool.pool.name
+}
+
+resource "ovh_cloud_project_kube_nodepool_node_operation" "replace" {
+  service_name = ovh_cloud_project_kube.cluster.service_name
+  kube_id      = ovh_cloud_project_kube.cluster.id
+  nodepool_id  = ovh_cloud_project_kube_nodepool.pool.id
+  node_name    = data.ovh_cloud_project_kube_nodepool_nodes.nodes.nodes[0].name
+  operation    = "replace"
+}
+`
+
+func TestAccCloudProjectKubeNodePoolNodeOperation_replace(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	config := fmt.Sprintf(
+		testAccCloudProjectKubeNodePoolNodeOperationConfig,
+		os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST"),
+		name,
+		os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloud(t)
+			testAccCheckCloudProjectExists(t)
+			testAccPreCheckKubernetes(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_kube_nodepool_node_operation.replace", "node_id"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube_nodepool_node_operation.replace", "desired_nodes", "1"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube_nodepool_node_operation.replace", "replacement_node.#", "1"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_kube_nodepool_node_operation.replace", "replacement_node.0.name"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube_nodepool_node_operation.replace", "replacement_node.0.status", "READY"),
+				),
+			},
+		},
+	})
+}
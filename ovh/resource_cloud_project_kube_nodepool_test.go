@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -11,12 +12,13 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 var (
-	effectTaintsErrorRegex       = regexp.MustCompile("(.)*effect attribute is mandatory for taint(.)*")
-	keyTaintsErrorRegex          = regexp.MustCompile("(.)*key attribute is mandatory for taint(.)*")
+	effectTaintsErrorRegex       = regexp.MustCompile(`(.)*"effect" is required(.)*`)
+	keyTaintsErrorRegex          = regexp.MustCompile(`(.)*"key" is required(.)*`)
 	valueNoCrashTaintsErrorRegex = regexp.MustCompile("(.)*This service does not exist(.)*")
 )
 
@@ -167,13 +169,12 @@ resource "ovh_cloud_project_kube_nodepool" "pool" {
     }
     spec {
       unschedulable = false
-      taints = [
-        {
-          effect = "PreferNoSchedule"
-          key    = "t1"
-          #value  = "tv1"
-        }
-      ]
+      # optional taint arguments can only be omitted with the block syntax
+      taints {
+        effect = "PreferNoSchedule"
+        key    = "t1"
+        #value  = "tv1"
+      }
     }
   }
 }
@@ -751,6 +752,117 @@ func TestAccCloudProjectKubeNodePoolRessource(t *testing.T) {
 	})
 }
 
+func Test_filterNodePoolTemplateLabels(t *testing.T) {
+	labels := map[string]string{
+		"l1":                     "lv1",
+		"node.k8s.ovh/type":      "standard",
+		"nodepool.k8s.ovh/owner": "ovh",
+	}
+	managed := map[string]interface{}{
+		"l1": "old",
+		"l2": "lv2",
+	}
+
+	want := map[string]string{"l1": "lv1"}
+	if got := filterNodePoolTemplateLabels(labels, managed); !reflect.DeepEqual(got, want) {
+		t.Errorf("filterNodePoolTemplateLabels() = %v, want %v", got, want)
+	}
+}
+
+func Test_kubeNodePoolReplacementName(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	if got := kubeNodePoolReplacementName("my-pool", now); got != "my-pool-rs44we8" {
+		t.Errorf("kubeNodePoolReplacementName(my-pool) = %s, want my-pool-rs44we8", got)
+	}
+
+	tests := []struct {
+		name     string
+		baseName string
+		old      string
+		new      string
+		want     bool
+	}{
+		{name: "replaced pool", baseName: "my-pool", old: "my-pool-rs44we8", new: "my-pool", want: true},
+		{name: "renamed replaced pool", baseName: "my-pool", old: "my-pool-rs44we8", new: "other-pool", want: false},
+		{name: "name looking like a replacement", baseName: "web-runner", old: "web-runner", new: "web", want: false},
+		{name: "rename dropping a suffix", baseName: "gpu-r1", old: "gpu-r1", new: "gpu", want: false},
+		{name: "name not configured", baseName: "my-pool", old: "my-pool-rs44we8", new: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := resourceCloudProjectKubeNodePool().TestResourceData()
+			d.Set("base_name", tt.baseName)
+			if got := kubeNodePoolReplacementNameDiffSuppress("name", tt.old, tt.new, d); got != tt.want {
+				t.Errorf("kubeNodePoolReplacementNameDiffSuppress(%s, %s) = %t, want %t", tt.old, tt.new, got, tt.want)
+			}
+		})
+	}
+}
+
+var testAccCloudProjectKubeNodePoolConfigReplacement = `
+resource "ovh_cloud_project_kube" "cluster" {
+  service_name = "%s"
+  name         = "%s"
+  region       = "%s"
+  version      = "%s"
+}
+
+resource "ovh_cloud_project_kube_nodepool" "pool" {
+  service_name         = ovh_cloud_project_kube.cluster.service_name
+  kube_id              = ovh_cloud_project_kube.cluster.id
+  name                 = ovh_cloud_project_kube.cluster.name
+  flavor_name          = "%s"
+  desired_nodes        = 1
+  min_nodes            = 0
+  max_nodes            = 1
+  replacement_strategy = "create_before_destroy_with_drain"
+}
+`
+
+func TestAccCloudProjectKubeNodePoolReplacementStrategy(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	region := os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST")
+	version := os.Getenv("OVH_CLOUD_PROJECT_KUBE_VERSION_TEST")
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	var poolId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloud(t)
+			testAccCheckCloudProjectExists(t)
+			testAccPreCheckKubernetes(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudProjectKubeNodePoolConfigReplacement, serviceName, name, region, version, "b2-7"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube_nodepool.pool", "flavor_name", "b2-7"),
+					func(state *terraform.State) error {
+						poolId = state.RootModule().Resources["ovh_cloud_project_kube_nodepool.pool"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCloudProjectKubeNodePoolConfigReplacement, serviceName, name, region, version, "b2-15"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube_nodepool.pool", "flavor_name", "b2-15"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube_nodepool.pool", "available_nodes", "1"),
+					resource.TestMatchResourceAttr("ovh_cloud_project_kube_nodepool.pool", "name", regexp.MustCompile("^"+name+"-r[0-9a-z]+$")),
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube_nodepool.pool", "base_name", name),
+					func(state *terraform.State) error {
+						if state.RootModule().Resources["ovh_cloud_project_kube_nodepool.pool"].Primary.ID == poolId {
+							return fmt.Errorf("nodepool %s was not replaced", poolId)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudProjectKubeNodePoolTaints(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -797,3 +909,321 @@ func TestAccCloudProjectKubeNodePoolTaints(t *testing.T) {
 		},
 	})
 }
+
+var testAccCloudProjectKubeNodePoolConfigDeletionProtection = `
+resource "ovh_cloud_project_kube" "cluster" {
+  service_name = "%s"
+  name         = "%s"
+  region       = "%s"
+}
+
+resource "ovh_cloud_project_kube_nodepool" "pool" {
+  service_name        = ovh_cloud_project_kube.cluster.service_name
+  kube_id             = ovh_cloud_project_kube.cluster.id
+  name                = ovh_cloud_project_kube.cluster.name
+  flavor_name         = "b2-7"
+  desired_nodes       = 1
+  deletion_protection = %t
+}
+`
+
+func TestAccCloudProjectKubeNodePoolDeletionProtection(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	region := os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST")
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloud(t)
+			testAccCheckCloudProjectExists(t)
+			testAccPreCheckKubernetes(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudProjectKubeNodePoolConfigDeletionProtection, serviceName, name, region, true),
+				Check:  resource.TestCheckResourceAttr("ovh_cloud_project_kube_nodepool.pool", "deletion_protection", "true"),
+			},
+			{
+				Config:      fmt.Sprintf(testAccCloudProjectKubeNodePoolConfigDeletionProtection, serviceName, name, region, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("can't be deleted while deletion_protection is set to true"),
+			},
+			{
+				// disable the protection so that the nodepool can be destroyed at the end of the test
+				Config: fmt.Sprintf(testAccCloudProjectKubeNodePoolConfigDeletionProtection, serviceName, name, region, false),
+				Check:  resource.TestCheckResourceAttr("ovh_cloud_project_kube_nodepool.pool", "deletion_protection", "false"),
+			},
+		},
+	})
+}
+
+func Test_validateKubeNodePoolSize(t *testing.T) {
+	size := func(v int) *int { return &v }
+
+	tests := []struct {
+		name              string
+		min, desired, max *int
+		wantErr           bool
+	}{
+		{name: "all unset"},
+		{name: "in range", min: size(1), desired: size(2), max: size(3)},
+		{name: "bounds", min: size(2), desired: size(2), max: size(2)},
+		{name: "only desired", desired: size(5)},
+		{name: "under min", min: size(2), desired: size(1), wantErr: true},
+		{name: "over max", desired: size(4), max: size(3), wantErr: true},
+		{name: "min over max", min: size(4), max: size(3), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateKubeNodePoolSize(tt.min, tt.desired, tt.max); (err != nil) != tt.wantErr {
+				t.Errorf("validateKubeNodePoolSize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_kubeNodePoolDesiredNodesDiffSuppress(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        string
+		autoscale bool
+		new       string
+		want      bool
+	}{
+		{name: "autoscaled pool", id: "pool", autoscale: true, new: "1", want: true},
+		{name: "autoscaled pool with a new size", id: "pool", autoscale: true, new: "2", want: false},
+		{name: "pool without autoscale", id: "pool", autoscale: false, new: "1", want: false},
+		{name: "new autoscaled pool", id: "", autoscale: true, new: "1", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := resourceCloudProjectKubeNodePool().TestResourceData()
+			d.SetId(tt.id)
+			d.Set("autoscale", tt.autoscale)
+			d.Set("applied_desired_nodes", 1)
+			if got := kubeNodePoolDesiredNodesDiffSuppress("desired_nodes", "3", tt.new, d); got != tt.want {
+				t.Errorf("kubeNodePoolDesiredNodesDiffSuppress() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+var testAccCloudProjectKubeNodePoolConfigAutoscaleSize = `
+resource "ovh_cloud_project_kube" "cluster" {
+  service_name = "%s"
+  name         = "%s"
+  region       = "%s"
+}
+
+resource "ovh_cloud_project_kube_nodepool" "pool" {
+  service_name  = ovh_cloud_project_kube.cluster.service_name
+  kube_id       = ovh_cloud_project_kube.cluster.id
+  name          = ovh_cloud_project_kube.cluster.name
+  flavor_name   = "b2-7"
+  autoscale     = true
+  desired_nodes = %d
+  min_nodes     = 1
+  max_nodes     = 2
+}
+`
+
+func TestAccCloudProjectKubeNodePoolAutoscaleSize(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	region := os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST")
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	var pool *terraform.InstanceState
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloud(t)
+			testAccCheckCloudProjectExists(t)
+			testAccPreCheckKubernetes(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testAccCloudProjectKubeNodePoolConfigAutoscaleSize, serviceName, name, region, 3),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`desired_nodes \(3\) must be lower than or equal to max_nodes \(2\)`),
+			},
+			{
+				Config: fmt.Sprintf(testAccCloudProjectKubeNodePoolConfigAutoscaleSize, serviceName, name, region, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube_nodepool.pool", "autoscale", "true"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube_nodepool.pool", "desired_nodes", "1"),
+					func(state *terraform.State) error {
+						pool = state.RootModule().Resources["ovh_cloud_project_kube_nodepool.pool"].Primary
+						return nil
+					},
+				),
+			},
+			{
+				// a new size in the configuration is applied even though the pool is autoscaled
+				Config: fmt.Sprintf(testAccCloudProjectKubeNodePoolConfigAutoscaleSize, serviceName, name, region, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("ovh_cloud_project_kube_nodepool.pool", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube_nodepool.pool", "desired_nodes", "2"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube_nodepool.pool", "applied_desired_nodes", "2"),
+				),
+			},
+			{
+				// scale the pool outside of terraform like the autoscaler does: the state shows
+				// the new size but the plan doesn't try to scale the pool back
+				PreConfig: func() {
+					kubeId := pool.Attributes["kube_id"]
+					endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool/%s", serviceName, kubeId, pool.ID)
+					if err := testAccOVHClient.Put(endpoint, map[string]interface{}{"desiredNodes": 1}, nil); err != nil {
+						t.Fatalf("calling Put %s: %s", endpoint, err)
+					}
+					if err := waitForCloudProjectKubeNodePoolWithStateTarget(testAccOVHClient, serviceName, kubeId, pool.ID, 20*time.Minute, []string{"READY"}); err != nil {
+						t.Fatalf("waiting for nodepool %s to be READY: %s", pool.ID, err)
+					}
+				},
+				Config: fmt.Sprintf(testAccCloudProjectKubeNodePoolConfigAutoscaleSize, serviceName, name, region, 2),
+				Check:  resource.TestCheckResourceAttr("ovh_cloud_project_kube_nodepool.pool", "desired_nodes", "1"),
+			},
+			{
+				Config:   fmt.Sprintf(testAccCloudProjectKubeNodePoolConfigAutoscaleSize, serviceName, name, region, 2),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+var testAccCloudProjectKubeNodePoolConfigCheckQuota = `
+resource "ovh_cloud_project_kube" "cluster" {
+  service_name = "%s"
+  name         = "%s"
+  region       = "%s"
+}
+
+resource "ovh_cloud_project_kube_nodepool" "pool" {
+  service_name  = ovh_cloud_project_kube.cluster.service_name
+  kube_id       = ovh_cloud_project_kube.cluster.id
+  name          = ovh_cloud_project_kube.cluster.name
+  flavor_name   = "b2-7"
+  autoscale     = true
+  desired_nodes = 1
+  min_nodes     = 1
+  max_nodes     = %d
+  check_quota   = %t
+}
+`
+
+func TestAccCloudProjectKubeNodePoolCheckQuota(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	region := os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST")
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloud(t)
+			testAccCheckCloudProjectExists(t)
+			testAccPreCheckKubernetes(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudProjectKubeNodePoolConfigCheckQuota, serviceName, name, region, 2, true),
+				Check:  resource.TestCheckResourceAttr("ovh_cloud_project_kube_nodepool.pool", "check_quota", "true"),
+			},
+			{
+				// the autoscaler may grow the pool up to max_nodes, beyond the default quotas of a project
+				Config:      fmt.Sprintf(testAccCloudProjectKubeNodePoolConfigCheckQuota, serviceName, name, region, 100, true),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`quotas of project .* exceeded`),
+			},
+			{
+				// without check_quota the exceeded quotas are only logged
+				Config:             fmt.Sprintf(testAccCloudProjectKubeNodePoolConfigCheckQuota, serviceName, name, region, 100, false),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func Test_validateKubeNodePoolAvailabilityZones(t *testing.T) {
+	regionZones := []string{"eu-west-par-a", "eu-west-par-b", "eu-west-par-c"}
+
+	if err := validateKubeNodePoolAvailabilityZones([]string{"eu-west-par-a", "eu-west-par-c"}, regionZones, "EU-WEST-PAR"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := validateKubeNodePoolAvailabilityZones([]string{"eu-west-par-d"}, regionZones, "EU-WEST-PAR"); err == nil {
+		t.Errorf("an unknown zone should raise an error")
+	}
+	if err := validateKubeNodePoolAvailabilityZones([]string{"gra11-a"}, nil, "GRA11"); err == nil {
+		t.Errorf("a region without zones should raise an error")
+	}
+}
+
+func Test_countKubeNodesPerAvailabilityZone(t *testing.T) {
+	got := countKubeNodesPerAvailabilityZone(
+		[]string{"eu-west-par-a", "eu-west-par-b", "eu-west-par-c"},
+		[]string{"eu-west-par-a", "eu-west-par-c", "eu-west-par-a", ""},
+	)
+	want := map[string]interface{}{
+		"eu-west-par-a": 2,
+		"eu-west-par-b": 0,
+		"eu-west-par-c": 1,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("countKubeNodesPerAvailabilityZone() = %v, want %v", got, want)
+	}
+}
+
+var testAccCloudProjectKubeNodePoolConfigMultiAZ = `
+resource "ovh_cloud_project_kube" "cluster" {
+  service_name = "%s"
+  name         = "%s"
+  region       = "%s"
+}
+
+resource "ovh_cloud_project_kube_nodepool" "pool" {
+  service_name       = ovh_cloud_project_kube.cluster.service_name
+  kube_id            = ovh_cloud_project_kube.cluster.id
+  name               = ovh_cloud_project_kube.cluster.name
+  flavor_name        = "b3-8"
+  desired_nodes      = 2
+  availability_zones = ["%s", "%s"]
+}
+
+data "ovh_cloud_project_kube_nodepool" "pool" {
+  service_name = ovh_cloud_project_kube_nodepool.pool.service_name
+  kube_id      = ovh_cloud_project_kube_nodepool.pool.kube_id
+  name         = ovh_cloud_project_kube_nodepool.pool.name
+}
+`
+
+func TestAccCloudProjectKubeNodePoolMultiAZ(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	region := os.Getenv("OVH_CLOUD_PROJECT_KUBE_MULTI_AZ_REGION_TEST")
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	zoneA := strings.ToLower(region) + "-a"
+	zoneB := strings.ToLower(region) + "-b"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloud(t)
+			testAccCheckCloudProjectExists(t)
+			testAccPreCheckKubernetesMultiAZ(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudProjectKubeNodePoolConfigMultiAZ, serviceName, name, region, zoneA, zoneB),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube_nodepool.pool", "availability_zones.#", "2"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube_nodepool.pool", "nodes_per_availability_zone.%", "2"),
+					resource.TestCheckResourceAttr("data.ovh_cloud_project_kube_nodepool.pool", "availability_zones.#", "2"),
+					resource.TestCheckResourceAttr("data.ovh_cloud_project_kube_nodepool.pool", "nodes_per_availability_zone.%", "2"),
+				),
+			},
+		},
+	})
+}
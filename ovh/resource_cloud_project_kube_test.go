@@ -88,6 +88,45 @@ resource "ovh_cloud_project_kube" "cluster" {
 }
 `
 
+var testAccCloudProjectKubeInitialNodePoolConfig = `
+resource "ovh_cloud_project_kube" "cluster" {
+	service_name  = "%s"
+	name          = "%s"
+	region        = "%s"
+
+	initial_nodepool {
+		name          = "%s"
+		flavor_name   = "b2-7"
+		desired_nodes = 1
+		min_nodes     = 1
+		max_nodes     = 2
+
+		template {
+			metadata {
+				labels = {
+					pool = "initial"
+				}
+			}
+		}
+	}
+}
+
+data "ovh_cloud_project_kube_nodepool" "initial" {
+	service_name = ovh_cloud_project_kube.cluster.service_name
+	kube_id      = ovh_cloud_project_kube.cluster.id
+	name         = ovh_cloud_project_kube.cluster.initial_nodepool[0].name
+}
+`
+
+var testAccCloudProjectKubeDeletionProtectionConfig = `
+resource "ovh_cloud_project_kube" "cluster" {
+	service_name        = "%s"
+	name                = "%s"
+	region              = "%s"
+	deletion_protection = %t
+}
+`
+
 var testAccCloudProjectKubeEmptyVersionConfig = `
 resource "ovh_cloud_project_kube" "cluster" {
 	service_name  = "%s"
@@ -1081,6 +1120,87 @@ func TestAccCloudProjectKube_basic(t *testing.T) {
 // check some properties
 // update cluster name
 // check some properties && cluster updated name
+func TestAccCloudProjectKubeInitialNodePool_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	config := fmt.Sprintf(
+		testAccCloudProjectKubeInitialNodePoolConfig,
+		os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST"),
+		name,
+		os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST"),
+		name,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloud(t)
+			testAccCheckCloudProjectExists(t)
+			testAccPreCheckKubernetes(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube.cluster", "status", "READY"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube.cluster", "initial_nodepool.0.name", name),
+					resource.TestCheckResourceAttr("data.ovh_cloud_project_kube_nodepool.initial", "status", "READY"),
+					resource.TestCheckResourceAttr("data.ovh_cloud_project_kube_nodepool.initial", "flavor_name", "b2-7"),
+					resource.TestCheckResourceAttr("data.ovh_cloud_project_kube_nodepool.initial", "desired_nodes", "1"),
+				),
+			},
+			{
+				// the initial nodepool is ignored once the cluster is created
+				Config:   strings.Replace(config, "desired_nodes = 1", "desired_nodes = 2", 1),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            "ovh_cloud_project_kube.cluster",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST") + "/",
+				ImportStateVerifyIgnore: []string{"kubeconfig", "initial_nodepool"},
+				ImportStatePersist:      true,
+			},
+			{
+				// the cluster imported without its initial nodepool isn't replaced
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudProjectKubeDeletionProtection(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	region := os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST")
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloud(t)
+			testAccCheckCloudProjectExists(t)
+			testAccPreCheckKubernetes(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudProjectKubeDeletionProtectionConfig, serviceName, name, region, true),
+				Check:  resource.TestCheckResourceAttr("ovh_cloud_project_kube.cluster", "deletion_protection", "true"),
+			},
+			{
+				Config:      fmt.Sprintf(testAccCloudProjectKubeDeletionProtectionConfig, serviceName, name, region, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("can't be deleted while deletion_protection is set to true"),
+			},
+			{
+				// disable the protection so that the cluster can be destroyed at the end of the test
+				Config: fmt.Sprintf(testAccCloudProjectKubeDeletionProtectionConfig, serviceName, name, region, false),
+				Check:  resource.TestCheckResourceAttr("ovh_cloud_project_kube.cluster", "deletion_protection", "false"),
+			},
+		},
+	})
+}
+
 func TestAccCloudProjectKubeEmptyVersion_basic(t *testing.T) {
 	region := os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST")
 
@@ -0,0 +1,306 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+const (
+	cloudProjectLoadbalancingActive  = "active"
+	cloudProjectLoadbalancingError   = "error"
+	cloudProjectLoadbalancingDeleted = "DELETED"
+)
+
+// cloudProjectLoadbalancingPendingStatus are the provisioning statuses of the load balancers,
+// listeners, pools, health monitors and members while a change is in progress
+var cloudProjectLoadbalancingPendingStatus = []string{"creating", "updating", "deleting"}
+
+func resourceCloudProjectLoadbalancer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudProjectLoadbalancerCreate,
+		Read:   resourceCloudProjectLoadbalancerRead,
+		Update: resourceCloudProjectLoadbalancerUpdate,
+		Delete: resourceCloudProjectLoadbalancerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudProjectLoadbalancerImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+				Description: "Service name of the resource representing the ID of the cloud project.",
+			},
+			"region_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Region of the load balancer",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the load balancer",
+			},
+			"flavor_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the flavor of the load balancer",
+			},
+			"network_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Openstack ID of the private network of the load balancer",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the subnet of the private network the VIP of the load balancer is taken from",
+			},
+			"floating_ip_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "ID of an existing floating IP to associate to the load balancer",
+				ConflictsWith: []string{"create_floating_ip"},
+			},
+			"create_floating_ip": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "Create a new floating IP associated to the load balancer",
+				ConflictsWith: []string{"floating_ip_id"},
+			},
+
+			// computed
+			"floating_ip": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Floating IP associated to the load balancer",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the floating IP",
+						},
+						"ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address of the floating IP",
+						},
+					},
+				},
+			},
+			"vip_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IP address of the load balancer in the private network",
+			},
+			"operating_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Operating status of the load balancer",
+			},
+			"provisioning_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Provisioning status of the load balancer",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date of the load balancer",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last update date of the load balancer",
+			},
+		},
+	}
+}
+
+func resourceCloudProjectLoadbalancerImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("import Id is not service_name/region_name/loadbalancer_id formatted")
+	}
+	d.SetId(splitId[2])
+	d.Set("service_name", splitId[0])
+	d.Set("region_name", splitId[1])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceCloudProjectLoadbalancerCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	params := (&CloudProjectLoadbalancerCreateOpts{}).FromResource(d)
+	endpoint := cloudProjectLoadbalancingEndpoint(serviceName, regionName, "loadbalancer")
+	op := &CloudProjectOperationResponse{}
+
+	log.Printf("[DEBUG] Will create load balancer: %s", params)
+	if err := config.OVHClient.Post(endpoint, params, op); err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %w", endpoint, params, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for operation %s creating load balancer %s", op.Id, params.Name)
+	op, err := waitForCloudProjectOperation(config.OVHClient, serviceName, op.Id, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	id := op.GetResourceId()
+	if id == "" {
+		return fmt.Errorf("operation %s creating load balancer %s has no resource id", op.Id, params.Name)
+	}
+	d.SetId(id)
+
+	endpoint = cloudProjectLoadbalancingEndpoint(serviceName, regionName, "loadbalancer", id)
+	if err := waitForCloudProjectLoadbalancingObject(config.OVHClient, endpoint, d.Timeout(schema.TimeoutCreate), cloudProjectLoadbalancingActive); err != nil {
+		return err
+	}
+
+	return resourceCloudProjectLoadbalancerRead(d, meta)
+}
+
+func resourceCloudProjectLoadbalancerRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	endpoint := cloudProjectLoadbalancingEndpoint(serviceName, regionName, "loadbalancer", d.Id())
+	res := &CloudProjectLoadbalancerResponse{}
+
+	log.Printf("[DEBUG] Will read load balancer %s", d.Id())
+	if err := config.OVHClient.Get(endpoint, res); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	for k, v := range res.ToMap() {
+		d.Set(k, v)
+	}
+
+	log.Printf("[DEBUG] Read load balancer: %+v", res)
+	return nil
+}
+
+func resourceCloudProjectLoadbalancerUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	endpoint := cloudProjectLoadbalancingEndpoint(serviceName, regionName, "loadbalancer", d.Id())
+	params := &CloudProjectLoadbalancerUpdateOpts{Name: d.Get("name").(string)}
+
+	log.Printf("[DEBUG] Will update load balancer %s: %+v", d.Id(), params)
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %+v:\n\t %w", endpoint, params, err)
+	}
+
+	if err := waitForCloudProjectLoadbalancingObject(config.OVHClient, endpoint, d.Timeout(schema.TimeoutUpdate), cloudProjectLoadbalancingActive); err != nil {
+		return err
+	}
+
+	return resourceCloudProjectLoadbalancerRead(d, meta)
+}
+
+func resourceCloudProjectLoadbalancerDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	endpoint := cloudProjectLoadbalancingEndpoint(serviceName, regionName, "loadbalancer", d.Id())
+
+	log.Printf("[DEBUG] Will delete load balancer %s", d.Id())
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	if err := waitForCloudProjectLoadbalancingObject(config.OVHClient, endpoint, d.Timeout(schema.TimeoutDelete), cloudProjectLoadbalancingDeleted); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// cloudProjectLoadbalancingEndpoint returns the endpoint of a load balancing object of a region
+func cloudProjectLoadbalancingEndpoint(serviceName, regionName string, path ...string) string {
+	endpoint := fmt.Sprintf("/cloud/project/%s/region/%s/loadbalancing",
+		url.PathEscape(serviceName),
+		url.PathEscape(regionName))
+	for _, p := range path {
+		endpoint += "/" + url.PathEscape(p)
+	}
+	return endpoint
+}
+
+// waitForCloudProjectLoadbalancingObject waits for the provisioning status of a load balancing object,
+// the target being DELETED once it has been removed
+func waitForCloudProjectLoadbalancingObject(c *ovh.Client, endpoint string, timeout time.Duration, target string) error {
+	pending := cloudProjectLoadbalancingPendingStatus
+	if target == cloudProjectLoadbalancingDeleted {
+		pending = append([]string{cloudProjectLoadbalancingActive}, pending...)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{target},
+		Refresh:    cloudProjectLoadbalancingObjectRefreshFunc(c, endpoint),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for %s to be %s", endpoint, target)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for %s to be %s: %s", endpoint, target, err)
+	}
+	return nil
+}
+
+func cloudProjectLoadbalancingObjectRefreshFunc(c *ovh.Client, endpoint string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res := &struct {
+			ProvisioningStatus string `json:"provisioningStatus"`
+		}{}
+		if err := c.Get(endpoint, res); err != nil {
+			if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+				return res, cloudProjectLoadbalancingDeleted, nil
+			}
+			return res, "", err
+		}
+
+		log.Printf("[DEBUG] Pending load balancing object: %s is %s", endpoint, res.ProvisioningStatus)
+		if res.ProvisioningStatus == cloudProjectLoadbalancingError {
+			return res, res.ProvisioningStatus, fmt.Errorf("%s is in error", endpoint)
+		}
+		return res, res.ProvisioningStatus, nil
+	}
+}
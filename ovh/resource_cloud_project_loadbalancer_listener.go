@@ -0,0 +1,243 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+const cloudProjectLoadbalancerListenerTerminatedHttps = "terminatedHTTPS"
+
+func resourceCloudProjectLoadbalancerListener() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudProjectLoadbalancerListenerCreate,
+		Read:   resourceCloudProjectLoadbalancerListenerRead,
+		Update: resourceCloudProjectLoadbalancerListenerUpdate,
+		Delete: resourceCloudProjectLoadbalancerListenerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudProjectLoadbalancerListenerImportState,
+		},
+
+		CustomizeDiff: resourceCloudProjectLoadbalancerListenerCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+				Description: "Service name of the resource representing the ID of the cloud project.",
+			},
+			"region_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Region of the load balancer",
+			},
+			"loadbalancer_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the load balancer",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the listener",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the listener",
+			},
+			"protocol": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Protocol of the listener",
+				ValidateFunc: helpers.ValidateEnum([]string{
+					"http", "https", "prometheus", "sctp", "tcp", cloudProjectLoadbalancerListenerTerminatedHttps, "udp",
+				}),
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Port the listener listens on",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if port := v.(int); port < 1 || port > 65535 {
+						errors = append(errors, fmt.Errorf("%s must be between 1 and 65535, got %d", k, port))
+					}
+					return
+				},
+			},
+			"certificate_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the TLS certificate of the key manager, required by the terminatedHTTPS protocol",
+			},
+			"allowed_cidrs": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "CIDRs allowed to reach the listener, all of them if empty",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+						if err := helpers.ValidateIpBlock(v.(string)); err != nil {
+							errors = append(errors, err)
+						}
+						return
+					},
+				},
+			},
+
+			// computed
+			"operating_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Operating status of the listener",
+			},
+			"provisioning_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Provisioning status of the listener",
+			},
+		},
+	}
+}
+
+func resourceCloudProjectLoadbalancerListenerImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("import Id is not service_name/region_name/listener_id formatted")
+	}
+	d.SetId(splitId[2])
+	d.Set("service_name", splitId[0])
+	d.Set("region_name", splitId[1])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceCloudProjectLoadbalancerListenerCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	params, err := (&CloudProjectLoadbalancerListenerCreateOpts{}).FromResource(d)
+	if err != nil {
+		return err
+	}
+	endpoint := cloudProjectLoadbalancingEndpoint(serviceName, regionName, "listener")
+	res := &CloudProjectLoadbalancerListenerResponse{}
+
+	log.Printf("[DEBUG] Will create listener: %+v", params)
+	if err := config.OVHClient.Post(endpoint, params, res); err != nil {
+		return fmt.Errorf("calling Post %s with params %+v:\n\t %w", endpoint, params, err)
+	}
+	d.SetId(res.Id)
+
+	endpoint = cloudProjectLoadbalancingEndpoint(serviceName, regionName, "listener", res.Id)
+	if err := waitForCloudProjectLoadbalancingObject(config.OVHClient, endpoint, d.Timeout(schema.TimeoutCreate), cloudProjectLoadbalancingActive); err != nil {
+		return err
+	}
+
+	return resourceCloudProjectLoadbalancerListenerRead(d, meta)
+}
+
+func resourceCloudProjectLoadbalancerListenerRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	endpoint := cloudProjectLoadbalancingEndpoint(serviceName, regionName, "listener", d.Id())
+	res := &CloudProjectLoadbalancerListenerResponse{}
+
+	log.Printf("[DEBUG] Will read listener %s", d.Id())
+	if err := config.OVHClient.Get(endpoint, res); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	for k, v := range res.ToMap() {
+		d.Set(k, v)
+	}
+
+	log.Printf("[DEBUG] Read listener: %+v", res)
+	return nil
+}
+
+func resourceCloudProjectLoadbalancerListenerUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	params, err := (&CloudProjectLoadbalancerListenerUpdateOpts{}).FromResource(d)
+	if err != nil {
+		return err
+	}
+	endpoint := cloudProjectLoadbalancingEndpoint(serviceName, regionName, "listener", d.Id())
+
+	log.Printf("[DEBUG] Will update listener %s: %+v", d.Id(), params)
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %+v:\n\t %w", endpoint, params, err)
+	}
+
+	if err := waitForCloudProjectLoadbalancingObject(config.OVHClient, endpoint, d.Timeout(schema.TimeoutUpdate), cloudProjectLoadbalancingActive); err != nil {
+		return err
+	}
+
+	return resourceCloudProjectLoadbalancerListenerRead(d, meta)
+}
+
+func resourceCloudProjectLoadbalancerListenerDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	endpoint := cloudProjectLoadbalancingEndpoint(serviceName, regionName, "listener", d.Id())
+
+	log.Printf("[DEBUG] Will delete listener %s", d.Id())
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	if err := waitForCloudProjectLoadbalancingObject(config.OVHClient, endpoint, d.Timeout(schema.TimeoutDelete), cloudProjectLoadbalancingDeleted); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudProjectLoadbalancerListenerCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// the certificate is usually created in the same plan
+	if !d.NewValueKnown("certificate_id") {
+		return nil
+	}
+	return validateCloudProjectLoadbalancerListenerCertificate(d.Get("protocol").(string), d.Get("certificate_id").(string))
+}
+
+// validateCloudProjectLoadbalancerListenerCertificate checks a certificate is given
+// if and only if the listener terminates the TLS connections
+func validateCloudProjectLoadbalancerListenerCertificate(protocol, certificateId string) error {
+	if protocol == cloudProjectLoadbalancerListenerTerminatedHttps && certificateId == "" {
+		return fmt.Errorf("certificate_id is required by the %s protocol", protocol)
+	}
+	if protocol != cloudProjectLoadbalancerListenerTerminatedHttps && certificateId != "" {
+		return fmt.Errorf("certificate_id can only be set with the %s protocol, not %s", cloudProjectLoadbalancerListenerTerminatedHttps, protocol)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_validateCloudProjectLoadbalancerListenerCertificate(t *testing.T) {
+	tests := []struct {
+		name          string
+		protocol      string
+		certificateId string
+		wantErr       bool
+	}{
+		{name: "http", protocol: "http", certificateId: "", wantErr: false},
+		{name: "terminated https", protocol: "terminatedHTTPS", certificateId: "cert", wantErr: false},
+		{name: "terminated https without certificate", protocol: "terminatedHTTPS", certificateId: "", wantErr: true},
+		{name: "tcp with certificate", protocol: "tcp", certificateId: "cert", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateCloudProjectLoadbalancerListenerCertificate(tt.protocol, tt.certificateId); (err != nil) != tt.wantErr {
+				t.Errorf("validateCloudProjectLoadbalancerListenerCertificate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+var testAccCloudProjectLoadbalancerListenerConfig = `
+resource "ovh_cloud_project_loadbalancer_listener" "listener" {
+  service_name    = ovh_cloud_project_loadbalancer.lb.service_name
+  region_name     = ovh_cloud_project_loadbalancer.lb.region_name
+  loadbalancer_id = ovh_cloud_project_loadbalancer.lb.id
+  name            = "%s"
+  description     = "%s"
+  protocol        = "http"
+  port            = 80
+  allowed_cidrs   = ["10.0.0.0/8"]
+}
+`
+
+func TestAccCloudProjectLoadbalancerListener_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	region := os.Getenv("OVH_CLOUD_PROJECT_REGION_TEST")
+	network := newTestAccCloudProjectLoadbalancerNetwork()
+	lbName := acctest.RandomWithPrefix(test_prefix)
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloudLoadbalancing(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: network.config(lbName, fmt.Sprintf(testAccCloudProjectLoadbalancerListenerConfig, name, "first")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_loadbalancer_listener.listener", "name", name),
+					resource.TestCheckResourceAttr("ovh_cloud_project_loadbalancer_listener.listener", "protocol", "http"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_loadbalancer_listener.listener", "port", "80"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_loadbalancer_listener.listener", "allowed_cidrs.0", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_loadbalancer_listener.listener", "provisioning_status", "active"),
+				),
+			},
+			{
+				Config: network.config(lbName, fmt.Sprintf(testAccCloudProjectLoadbalancerListenerConfig, name, "second")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_loadbalancer_listener.listener", "description", "second"),
+				),
+			},
+			{
+				ResourceName:            "ovh_cloud_project_loadbalancer_listener.listener",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     serviceName + "/" + region + "/",
+				ImportStateVerifyIgnore: []string{"operating_status"},
+			},
+		},
+	})
+}
@@ -0,0 +1,215 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceCloudProjectLoadbalancerMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudProjectLoadbalancerMemberCreate,
+		Read:   resourceCloudProjectLoadbalancerMemberRead,
+		Update: resourceCloudProjectLoadbalancerMemberUpdate,
+		Delete: resourceCloudProjectLoadbalancerMemberDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudProjectLoadbalancerMemberImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+				Description: "Service name of the resource representing the ID of the cloud project.",
+			},
+			"region_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Region of the load balancer",
+			},
+			"pool_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the pool",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the member",
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "IP address of the member",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if err := helpers.ValidateIp(v.(string)); err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"protocol_port": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Port the member is reached on",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if port := v.(int); port < 1 || port > 65535 {
+						errors = append(errors, fmt.Errorf("%s must be between 1 and 65535, got %d", k, port))
+					}
+					return
+				},
+			},
+			"weight": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Weight of the member in the pool, between 0 and 256. A member with a weight of 0 receives no new connection",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if weight := v.(int); weight < 0 || weight > 256 {
+						errors = append(errors, fmt.Errorf("%s must be between 0 and 256, got %d", k, weight))
+					}
+					return
+				},
+			},
+
+			// computed
+			"operating_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Operating status of the member",
+			},
+			"provisioning_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Provisioning status of the member",
+			},
+		},
+	}
+}
+
+func resourceCloudProjectLoadbalancerMemberImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 4)
+	if len(splitId) != 4 {
+		return nil, fmt.Errorf("import Id is not service_name/region_name/pool_id/member_id formatted")
+	}
+	d.SetId(splitId[3])
+	d.Set("service_name", splitId[0])
+	d.Set("region_name", splitId[1])
+	d.Set("pool_id", splitId[2])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceCloudProjectLoadbalancerMemberCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+	poolId := d.Get("pool_id").(string)
+
+	params := (&CloudProjectLoadbalancerMemberCreateOpts{}).FromResource(d)
+	endpoint := cloudProjectLoadbalancingEndpoint(serviceName, regionName, "pool", poolId, "member")
+	var res []CloudProjectLoadbalancerMemberResponse
+
+	log.Printf("[DEBUG] Will add member to pool %s: %+v", poolId, params)
+	if err := config.OVHClient.Post(endpoint, params, &res); err != nil {
+		return fmt.Errorf("calling Post %s with params %+v:\n\t %w", endpoint, params, err)
+	}
+	if len(res) != 1 {
+		return fmt.Errorf("calling Post %s returned %d members instead of 1", endpoint, len(res))
+	}
+	d.SetId(res[0].Id)
+
+	endpoint = cloudProjectLoadbalancingEndpoint(serviceName, regionName, "pool", poolId, "member", res[0].Id)
+	if err := waitForCloudProjectLoadbalancingObject(config.OVHClient, endpoint, d.Timeout(schema.TimeoutCreate), cloudProjectLoadbalancingActive); err != nil {
+		return err
+	}
+
+	return resourceCloudProjectLoadbalancerMemberRead(d, meta)
+}
+
+func resourceCloudProjectLoadbalancerMemberRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+	poolId := d.Get("pool_id").(string)
+
+	endpoint := cloudProjectLoadbalancingEndpoint(serviceName, regionName, "pool", poolId, "member", d.Id())
+	res := &CloudProjectLoadbalancerMemberResponse{}
+
+	log.Printf("[DEBUG] Will read member %s of pool %s", d.Id(), poolId)
+	if err := config.OVHClient.Get(endpoint, res); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	for k, v := range res.ToMap() {
+		d.Set(k, v)
+	}
+
+	log.Printf("[DEBUG] Read member: %+v", res)
+	return nil
+}
+
+func resourceCloudProjectLoadbalancerMemberUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+	poolId := d.Get("pool_id").(string)
+
+	endpoint := cloudProjectLoadbalancingEndpoint(serviceName, regionName, "pool", poolId, "member", d.Id())
+	params := &CloudProjectLoadbalancerMemberUpdateOpts{
+		Name:   d.Get("name").(string),
+		Weight: d.Get("weight").(int),
+	}
+
+	log.Printf("[DEBUG] Will update member %s of pool %s: %+v", d.Id(), poolId, params)
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %+v:\n\t %w", endpoint, params, err)
+	}
+
+	if err := waitForCloudProjectLoadbalancingObject(config.OVHClient, endpoint, d.Timeout(schema.TimeoutUpdate), cloudProjectLoadbalancingActive); err != nil {
+		return err
+	}
+
+	return resourceCloudProjectLoadbalancerMemberRead(d, meta)
+}
+
+func resourceCloudProjectLoadbalancerMemberDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+	poolId := d.Get("pool_id").(string)
+
+	endpoint := cloudProjectLoadbalancingEndpoint(serviceName, regionName, "pool", poolId, "member", d.Id())
+
+	log.Printf("[DEBUG] Will delete member %s of pool %s", d.Id(), poolId)
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	if err := waitForCloudProjectLoadbalancingObject(config.OVHClient, endpoint, d.Timeout(schema.TimeoutDelete), cloudProjectLoadbalancingDeleted); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,74 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var testAccCloudProjectLoadbalancerMemberConfig = `
+resource "ovh_cloud_project_loadbalancer_pool" "pool" {
+  service_name    = ovh_cloud_project_loadbalancer.lb.service_name
+  region_name     = ovh_cloud_project_loadbalancer.lb.region_name
+  loadbalancer_id = ovh_cloud_project_loadbalancer.lb.id
+  name            = "%s"
+  protocol        = "tcp"
+  algorithm       = "roundRobin"
+}
+
+resource "ovh_cloud_project_loadbalancer_member" "member" {
+  service_name  = ovh_cloud_project_loadbalancer_pool.pool.service_name
+  region_name   = ovh_cloud_project_loadbalancer_pool.pool.region_name
+  pool_id       = ovh_cloud_project_loadbalancer_pool.pool.id
+  name          = "%s"
+  address       = "10.0.0.10"
+  protocol_port = 8080
+  weight        = %d
+}
+`
+
+func TestAccCloudProjectLoadbalancerMember_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	region := os.Getenv("OVH_CLOUD_PROJECT_REGION_TEST")
+	network := newTestAccCloudProjectLoadbalancerNetwork()
+	lbName := acctest.RandomWithPrefix(test_prefix)
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloudLoadbalancing(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: network.config(lbName, fmt.Sprintf(testAccCloudProjectLoadbalancerMemberConfig, name, name, 1)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_loadbalancer_member.member", "address", "10.0.0.10"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_loadbalancer_member.member", "protocol_port", "8080"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_loadbalancer_member.member", "weight", "1"),
+				),
+			},
+			{
+				// the member is drained in place
+				Config: network.config(lbName, fmt.Sprintf(testAccCloudProjectLoadbalancerMemberConfig, name, name, 0)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_loadbalancer_member.member", "weight", "0"),
+				),
+			},
+			{
+				ResourceName:      "ovh_cloud_project_loadbalancer_member.member",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					member := s.RootModule().Resources["ovh_cloud_project_loadbalancer_member.member"]
+					return fmt.Sprintf("%s/%s/%s/%s", serviceName, region, member.Primary.Attributes["pool_id"], member.Primary.ID), nil
+				},
+				ImportStateVerifyIgnore: []string{"operating_status"},
+			},
+		},
+	})
+}
@@ -0,0 +1,395 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+const cloudProjectLoadbalancerPoolAppCookie = "appCookie"
+
+func resourceCloudProjectLoadbalancerPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudProjectLoadbalancerPoolCreate,
+		Read:   resourceCloudProjectLoadbalancerPoolRead,
+		Update: resourceCloudProjectLoadbalancerPoolUpdate,
+		Delete: resourceCloudProjectLoadbalancerPoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudProjectLoadbalancerPoolImportState,
+		},
+
+		CustomizeDiff: resourceCloudProjectLoadbalancerPoolCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+				Description: "Service name of the resource representing the ID of the cloud project.",
+			},
+			"region_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Region of the load balancer",
+			},
+			"loadbalancer_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "ID of the load balancer of the pool, when it isn't the default pool of a listener",
+				ExactlyOneOf: []string{"loadbalancer_id", "listener_id"},
+			},
+			"listener_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "ID of the listener the pool is the default pool of",
+				ExactlyOneOf: []string{"loadbalancer_id", "listener_id"},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the pool",
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Protocol used to reach the members of the pool",
+				ValidateFunc: helpers.ValidateEnum([]string{"http", "https", "proxy", "proxyV2", "sctp", "tcp", "udp"}),
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Load balancing algorithm of the pool",
+				ValidateFunc: helpers.ValidateEnum([]string{"roundRobin", "leastConnections", "sourceIP"}),
+			},
+			"session_persistence": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Session persistence of the pool",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Type of session persistence",
+							ValidateFunc: helpers.ValidateEnum([]string{"sourceIP", "httpCookie", cloudProjectLoadbalancerPoolAppCookie}),
+						},
+						"cookie_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the application cookie, required by the appCookie type",
+						},
+					},
+				},
+			},
+			"health_monitor": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Health monitor checking the members of the pool",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the health monitor",
+						},
+						"monitor_type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Type of the health monitor",
+							ValidateFunc: helpers.ValidateEnum([]string{
+								"http", "https", "ping", "sctp", "tcp", "tls-hello", "udp-connect",
+							}),
+						},
+						"delay": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     5,
+							Description: "Interval in seconds between the checks",
+						},
+						"timeout": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     4,
+							Description: "Timeout in seconds of a check, it must be lower than the delay",
+						},
+						"max_retries": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     3,
+							Description: "Number of successful checks before a member becomes online",
+						},
+						"max_retries_down": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     3,
+							Description: "Number of failed checks before a member becomes offline",
+						},
+						"url_path": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Path requested by the http and https monitors",
+						},
+						"http_method": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Method used by the http and https monitors",
+						},
+						"expected_codes": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "HTTP status codes expected by the http and https monitors, e.g. 200 or 200-204",
+						},
+					},
+				},
+			},
+
+			// computed
+			"operating_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Operating status of the pool",
+			},
+			"provisioning_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Provisioning status of the pool",
+			},
+		},
+	}
+}
+
+func resourceCloudProjectLoadbalancerPoolImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("import Id is not service_name/region_name/pool_id formatted")
+	}
+	d.SetId(splitId[2])
+	d.Set("service_name", splitId[0])
+	d.Set("region_name", splitId[1])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceCloudProjectLoadbalancerPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	params := (&CloudProjectLoadbalancerPoolCreateOpts{}).FromResource(d)
+	endpoint := cloudProjectLoadbalancingEndpoint(serviceName, regionName, "pool")
+	res := &CloudProjectLoadbalancerPoolResponse{}
+
+	log.Printf("[DEBUG] Will create pool: %+v", params)
+	if err := config.OVHClient.Post(endpoint, params, res); err != nil {
+		return fmt.Errorf("calling Post %s with params %+v:\n\t %w", endpoint, params, err)
+	}
+	d.SetId(res.Id)
+
+	endpoint = cloudProjectLoadbalancingEndpoint(serviceName, regionName, "pool", res.Id)
+	if err := waitForCloudProjectLoadbalancingObject(config.OVHClient, endpoint, d.Timeout(schema.TimeoutCreate), cloudProjectLoadbalancingActive); err != nil {
+		return err
+	}
+
+	if monitor := (&CloudProjectLoadbalancerHealthMonitor{}).FromResource(d); monitor != nil {
+		if err := createCloudProjectLoadbalancerHealthMonitor(config.OVHClient, serviceName, regionName, monitor, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceCloudProjectLoadbalancerPoolRead(d, meta)
+}
+
+func resourceCloudProjectLoadbalancerPoolRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	endpoint := cloudProjectLoadbalancingEndpoint(serviceName, regionName, "pool", d.Id())
+	res := &CloudProjectLoadbalancerPoolResponse{}
+
+	log.Printf("[DEBUG] Will read pool %s", d.Id())
+	if err := config.OVHClient.Get(endpoint, res); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	for k, v := range res.ToMap() {
+		d.Set(k, v)
+	}
+
+	monitor, err := getCloudProjectLoadbalancerPoolHealthMonitor(config.OVHClient, serviceName, regionName, d.Id())
+	if err != nil {
+		return err
+	}
+	healthMonitor := make([]map[string]interface{}, 0, 1)
+	if monitor != nil {
+		healthMonitor = append(healthMonitor, monitor.ToMap())
+	}
+	d.Set("health_monitor", healthMonitor)
+
+	log.Printf("[DEBUG] Read pool: %+v", res)
+	return nil
+}
+
+func resourceCloudProjectLoadbalancerPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	endpoint := cloudProjectLoadbalancingEndpoint(serviceName, regionName, "pool", d.Id())
+
+	if d.HasChanges("name", "algorithm", "session_persistence") {
+		params := (&CloudProjectLoadbalancerPoolUpdateOpts{}).FromResource(d)
+
+		log.Printf("[DEBUG] Will update pool %s: %+v", d.Id(), params)
+		if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+			return fmt.Errorf("calling Put %s with params %+v:\n\t %w", endpoint, params, err)
+		}
+
+		if err := waitForCloudProjectLoadbalancingObject(config.OVHClient, endpoint, d.Timeout(schema.TimeoutUpdate), cloudProjectLoadbalancingActive); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("health_monitor") {
+		if err := updateCloudProjectLoadbalancerPoolHealthMonitor(d, config.OVHClient, serviceName, regionName); err != nil {
+			return err
+		}
+	}
+
+	return resourceCloudProjectLoadbalancerPoolRead(d, meta)
+}
+
+func resourceCloudProjectLoadbalancerPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	// the health monitor and the members are deleted with the pool
+	endpoint := cloudProjectLoadbalancingEndpoint(serviceName, regionName, "pool", d.Id())
+
+	log.Printf("[DEBUG] Will delete pool %s", d.Id())
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	if err := waitForCloudProjectLoadbalancingObject(config.OVHClient, endpoint, d.Timeout(schema.TimeoutDelete), cloudProjectLoadbalancingDeleted); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudProjectLoadbalancerPoolCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return validateCloudProjectLoadbalancerPoolSessionPersistence(
+		d.Get("session_persistence.0.type").(string),
+		d.Get("session_persistence.0.cookie_name").(string),
+	)
+}
+
+// validateCloudProjectLoadbalancerPoolSessionPersistence checks a cookie name is given
+// if and only if the sessions are persisted with an application cookie
+func validateCloudProjectLoadbalancerPoolSessionPersistence(persistenceType, cookieName string) error {
+	if persistenceType == cloudProjectLoadbalancerPoolAppCookie && cookieName == "" {
+		return fmt.Errorf("session_persistence cookie_name is required by the %s type", persistenceType)
+	}
+	if persistenceType != cloudProjectLoadbalancerPoolAppCookie && cookieName != "" {
+		return fmt.Errorf("session_persistence cookie_name can only be set with the %s type", cloudProjectLoadbalancerPoolAppCookie)
+	}
+	return nil
+}
+
+// updateCloudProjectLoadbalancerPoolHealthMonitor creates, updates or deletes the health monitor of the pool,
+// its type can't be changed so it is replaced instead
+func updateCloudProjectLoadbalancerPoolHealthMonitor(d *schema.ResourceData, c *ovh.Client, serviceName, regionName string) error {
+	oldType, newType := d.GetChange("health_monitor.0.monitor_type")
+	oldId, _ := d.GetChange("health_monitor.0.id")
+	monitorId := oldId.(string)
+	monitor := (&CloudProjectLoadbalancerHealthMonitor{}).FromResource(d)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	if monitorId != "" && (monitor == nil || oldType.(string) != newType.(string)) {
+		endpoint := cloudProjectLoadbalancingEndpoint(serviceName, regionName, "healthMonitor", monitorId)
+
+		log.Printf("[DEBUG] Will delete health monitor %s of pool %s", monitorId, d.Id())
+		if err := c.Delete(endpoint, nil); err != nil {
+			return fmt.Errorf("calling Delete %s:\n\t %w", endpoint, err)
+		}
+		if err := waitForCloudProjectLoadbalancingObject(c, endpoint, timeout, cloudProjectLoadbalancingDeleted); err != nil {
+			return err
+		}
+		monitorId = ""
+	}
+
+	if monitor == nil {
+		return nil
+	}
+
+	if monitorId == "" {
+		return createCloudProjectLoadbalancerHealthMonitor(c, serviceName, regionName, monitor, timeout)
+	}
+
+	endpoint := cloudProjectLoadbalancingEndpoint(serviceName, regionName, "healthMonitor", monitorId)
+	monitor.PoolId = ""
+
+	log.Printf("[DEBUG] Will update health monitor %s of pool %s: %+v", monitorId, d.Id(), monitor)
+	if err := c.Put(endpoint, monitor, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %+v:\n\t %w", endpoint, monitor, err)
+	}
+	return waitForCloudProjectLoadbalancingObject(c, endpoint, timeout, cloudProjectLoadbalancingActive)
+}
+
+func createCloudProjectLoadbalancerHealthMonitor(c *ovh.Client, serviceName, regionName string, monitor *CloudProjectLoadbalancerHealthMonitor, timeout time.Duration) error {
+	endpoint := cloudProjectLoadbalancingEndpoint(serviceName, regionName, "healthMonitor")
+	res := &CloudProjectLoadbalancerHealthMonitorResponse{}
+
+	log.Printf("[DEBUG] Will create health monitor of pool %s: %+v", monitor.PoolId, monitor)
+	if err := c.Post(endpoint, monitor, res); err != nil {
+		return fmt.Errorf("calling Post %s with params %+v:\n\t %w", endpoint, monitor, err)
+	}
+
+	endpoint = cloudProjectLoadbalancingEndpoint(serviceName, regionName, "healthMonitor", res.Id)
+	return waitForCloudProjectLoadbalancingObject(c, endpoint, timeout, cloudProjectLoadbalancingActive)
+}
+
+// getCloudProjectLoadbalancerPoolHealthMonitor returns the health monitor of the pool, or nil if it has none
+func getCloudProjectLoadbalancerPoolHealthMonitor(c *ovh.Client, serviceName, regionName, poolId string) (*CloudProjectLoadbalancerHealthMonitorResponse, error) {
+	endpoint := cloudProjectLoadbalancingEndpoint(serviceName, regionName, "healthMonitor")
+	var monitors []CloudProjectLoadbalancerHealthMonitorResponse
+
+	if err := c.Get(endpoint, &monitors); err != nil {
+		return nil, fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+
+	for i := range monitors {
+		if monitors[i].PoolId == poolId {
+			return &monitors[i], nil
+		}
+	}
+	return nil, nil
+}
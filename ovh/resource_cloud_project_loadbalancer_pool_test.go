@@ -0,0 +1,106 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_validateCloudProjectLoadbalancerPoolSessionPersistence(t *testing.T) {
+	tests := []struct {
+		name            string
+		persistenceType string
+		cookieName      string
+		wantErr         bool
+	}{
+		{name: "none", persistenceType: "", cookieName: "", wantErr: false},
+		{name: "source ip", persistenceType: "sourceIP", cookieName: "", wantErr: false},
+		{name: "app cookie", persistenceType: "appCookie", cookieName: "SESSION", wantErr: false},
+		{name: "app cookie without name", persistenceType: "appCookie", cookieName: "", wantErr: true},
+		{name: "http cookie with name", persistenceType: "httpCookie", cookieName: "SESSION", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateCloudProjectLoadbalancerPoolSessionPersistence(tt.persistenceType, tt.cookieName); (err != nil) != tt.wantErr {
+				t.Errorf("validateCloudProjectLoadbalancerPoolSessionPersistence() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+var testAccCloudProjectLoadbalancerPoolConfig = `
+resource "ovh_cloud_project_loadbalancer_listener" "listener" {
+  service_name    = ovh_cloud_project_loadbalancer.lb.service_name
+  region_name     = ovh_cloud_project_loadbalancer.lb.region_name
+  loadbalancer_id = ovh_cloud_project_loadbalancer.lb.id
+  name            = "%s"
+  protocol        = "http"
+  port            = 80
+}
+
+resource "ovh_cloud_project_loadbalancer_pool" "pool" {
+  service_name = ovh_cloud_project_loadbalancer_listener.listener.service_name
+  region_name  = ovh_cloud_project_loadbalancer_listener.listener.region_name
+  listener_id  = ovh_cloud_project_loadbalancer_listener.listener.id
+  name         = "%s"
+  protocol     = "http"
+  algorithm    = "%s"
+
+  session_persistence {
+    type = "httpCookie"
+  }
+
+  health_monitor {
+    monitor_type   = "http"
+    url_path       = "/health"
+    expected_codes = "200"
+    delay          = %d
+  }
+}
+`
+
+func TestAccCloudProjectLoadbalancerPool_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	region := os.Getenv("OVH_CLOUD_PROJECT_REGION_TEST")
+	network := newTestAccCloudProjectLoadbalancerNetwork()
+	lbName := acctest.RandomWithPrefix(test_prefix)
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloudLoadbalancing(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: network.config(lbName, fmt.Sprintf(testAccCloudProjectLoadbalancerPoolConfig, name, name, "roundRobin", 5)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_loadbalancer_pool.pool", "algorithm", "roundRobin"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_loadbalancer_pool.pool", "session_persistence.0.type", "httpCookie"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_loadbalancer_pool.pool", "health_monitor.0.monitor_type", "http"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_loadbalancer_pool.pool", "health_monitor.0.url_path", "/health"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_loadbalancer_pool.pool", "health_monitor.0.id"),
+				),
+			},
+			{
+				// the algorithm and the health monitor are updated in place
+				Config: network.config(lbName, fmt.Sprintf(testAccCloudProjectLoadbalancerPoolConfig, name, name, "leastConnections", 10)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_loadbalancer_pool.pool", "algorithm", "leastConnections"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_loadbalancer_pool.pool", "health_monitor.0.delay", "10"),
+				),
+			},
+			{
+				ResourceName:        "ovh_cloud_project_loadbalancer_pool.pool",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: serviceName + "/" + region + "/",
+				// the API doesn't return the parent of the pool
+				ImportStateVerifyIgnore: []string{"listener_id", "operating_status"},
+			},
+		},
+	})
+}
@@ -0,0 +1,163 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("ovh_cloud_project_loadbalancer", &resource.Sweeper{
+		Name: "ovh_cloud_project_loadbalancer",
+		F:    testSweepCloudProjectLoadbalancer,
+	})
+}
+
+func testSweepCloudProjectLoadbalancer(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	regionName := os.Getenv("OVH_CLOUD_PROJECT_REGION_TEST")
+	if serviceName == "" || regionName == "" {
+		log.Print("[DEBUG] OVH_CLOUD_PROJECT_SERVICE_TEST or OVH_CLOUD_PROJECT_REGION_TEST is not set. No load balancer to sweep")
+		return nil
+	}
+
+	loadbalancers := make([]CloudProjectLoadbalancerResponse, 0)
+	endpoint := cloudProjectLoadbalancingEndpoint(serviceName, regionName, "loadbalancer")
+	if err := client.Get(endpoint, &loadbalancers); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	for _, lb := range loadbalancers {
+		if !strings.HasPrefix(lb.Name, test_prefix) {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting load balancer %s/%s", lb.Name, lb.Id)
+		if err := client.Delete(cloudProjectLoadbalancingEndpoint(serviceName, regionName, "loadbalancer", lb.Id), nil); err != nil {
+			return fmt.Errorf("Error deleting load balancer %s:\n\t %q", lb.Id, err)
+		}
+	}
+	return nil
+}
+
+func Test_cloudProjectLoadbalancingEndpoint(t *testing.T) {
+	got := cloudProjectLoadbalancingEndpoint("project", "GRA9", "pool", "pool-id", "member")
+	want := "/cloud/project/project/region/GRA9/loadbalancing/pool/pool-id/member"
+	if got != want {
+		t.Errorf("cloudProjectLoadbalancingEndpoint() = %s, want %s", got, want)
+	}
+}
+
+// testAccCloudProjectLoadbalancerConfig is a load balancer in a private network,
+// shared by the listener, pool and member tests
+var testAccCloudProjectLoadbalancerConfig = `
+resource "ovh_vrack_cloudproject" "attach" {
+  service_name = "%s"
+  project_id   = "%s"
+}
+
+resource "ovh_cloud_project_network_private" "network" {
+  service_name = ovh_vrack_cloudproject.attach.project_id
+  vlan_id      = %d
+  name         = "%s"
+  regions      = ["%s"]
+}
+
+resource "ovh_cloud_project_network_private_subnet" "subnet" {
+  service_name = ovh_cloud_project_network_private.network.service_name
+  network_id   = ovh_cloud_project_network_private.network.id
+  region       = "%s"
+  start        = "10.0.0.2"
+  end          = "10.0.255.254"
+  network      = "10.0.0.0/16"
+  dhcp         = true
+}
+
+resource "ovh_cloud_project_loadbalancer" "lb" {
+  service_name = ovh_cloud_project_network_private.network.service_name
+  region_name  = ovh_cloud_project_network_private_subnet.subnet.region
+  name         = "%s"
+  flavor_id    = "%s"
+  network_id   = tolist(ovh_cloud_project_network_private.network.regions_attributes[*].openstackid)[0]
+  subnet_id    = ovh_cloud_project_network_private_subnet.subnet.id
+}
+`
+
+// testAccCloudProjectLoadbalancerNetwork holds the random values of the network
+// of a test, which must stay the same between its steps
+type testAccCloudProjectLoadbalancerNetwork struct {
+	vlanId int
+	name   string
+}
+
+func newTestAccCloudProjectLoadbalancerNetwork() testAccCloudProjectLoadbalancerNetwork {
+	return testAccCloudProjectLoadbalancerNetwork{
+		vlanId: acctest.RandIntRange(100, 200),
+		name:   acctest.RandomWithPrefix(test_prefix),
+	}
+}
+
+func (n testAccCloudProjectLoadbalancerNetwork) config(lbName, extra string) string {
+	region := os.Getenv("OVH_CLOUD_PROJECT_REGION_TEST")
+	return fmt.Sprintf(
+		testAccCloudProjectLoadbalancerConfig,
+		os.Getenv("OVH_VRACK_SERVICE_TEST"),
+		os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST"),
+		n.vlanId,
+		n.name,
+		region,
+		region,
+		lbName,
+		os.Getenv("OVH_CLOUD_PROJECT_LOADBALANCER_FLAVOR_ID_TEST"),
+	) + extra
+}
+
+func TestAccCloudProjectLoadbalancer_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	region := os.Getenv("OVH_CLOUD_PROJECT_REGION_TEST")
+	network := newTestAccCloudProjectLoadbalancerNetwork()
+	name := acctest.RandomWithPrefix(test_prefix)
+	updatedName := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloudLoadbalancing(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: network.config(name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_loadbalancer.lb", "name", name),
+					resource.TestCheckResourceAttr("ovh_cloud_project_loadbalancer.lb", "region_name", region),
+					resource.TestCheckResourceAttr("ovh_cloud_project_loadbalancer.lb", "provisioning_status", "active"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_loadbalancer.lb", "vip_address"),
+				),
+			},
+			{
+				// the load balancer is renamed in place
+				Config: network.config(updatedName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_loadbalancer.lb", "name", updatedName),
+				),
+			},
+			{
+				ResourceName:            "ovh_cloud_project_loadbalancer.lb",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     serviceName + "/" + region + "/",
+				ImportStateVerifyIgnore: []string{"operating_status", "updated_at"},
+			},
+		},
+	})
+}
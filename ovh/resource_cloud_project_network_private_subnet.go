@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"net/url"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -36,6 +37,7 @@ func resourceCloudProjectNetworkPrivateSubnet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCloudProjectNetworkPrivateSubnetCreate,
 		Read:   resourceCloudProjectNetworkPrivateSubnetRead,
+		Update: resourceCloudProjectNetworkPrivateSubnetUpdate,
 		Delete: resourceCloudProjectNetworkPrivateSubnetDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceOvhCloudProjectNetworkPrivateSubnetImportState,
@@ -57,7 +59,6 @@ func resourceCloudProjectNetworkPrivateSubnet() *schema.Resource {
 			"dhcp": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
 				Default:  false,
 			},
 			"start": {
@@ -76,7 +77,7 @@ func resourceCloudProjectNetworkPrivateSubnet() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: resourceCloudProjectNetworkPrivateSubnetValidateNetwork,
+				ValidateFunc: resourceCloudProjectNetworkPrivateSubnetValidateIPv4Network,
 			},
 			"region": {
 				Type:     schema.TypeString,
@@ -86,9 +87,10 @@ func resourceCloudProjectNetworkPrivateSubnet() *schema.Resource {
 			"no_gateway": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
 				Default:  false,
 			},
+			"dns_nameservers": cloudProjectNetworkSubnetDnsNameserversSchema(),
+			"host_routes":     cloudProjectNetworkSubnetHostRoutesSchema(),
 			"gateway_ip": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -163,13 +165,70 @@ func resourceCloudProjectNetworkPrivateSubnetCreate(d *schema.ResourceData, meta
 	//set id
 	d.SetId(r.Id)
 
+	// the DNS nameservers and host routes are only handled by the regional endpoint
+	_, dnsOk := d.GetOk("dns_nameservers")
+	_, routesOk := d.GetOk("host_routes")
+	if dnsOk || routesOk {
+		if err := updateCloudProjectNetworkPrivateSubnet(d, config); err != nil {
+			return err
+		}
+	}
+
 	return resourceCloudProjectNetworkPrivateSubnetRead(d, meta)
 }
 
+func resourceCloudProjectNetworkPrivateSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if err := updateCloudProjectNetworkPrivateSubnet(d, config); err != nil {
+		return err
+	}
+
+	return resourceCloudProjectNetworkPrivateSubnetRead(d, meta)
+}
+
+// updateCloudProjectNetworkPrivateSubnet updates the subnet through the regional endpoint,
+// the vRack one having no update
+func updateCloudProjectNetworkPrivateSubnet(d *schema.ResourceData, config *Config) error {
+	serviceName := d.Get("service_name").(string)
+	region := d.Get("region").(string)
+
+	openstackId, err := getCloudProjectNetworkPrivateOpenstackId(config, serviceName, d.Get("network_id").(string), region)
+	if err != nil {
+		return err
+	}
+
+	params := &CloudProjectNetworkSubnetUpdateOpts{
+		EnableDhcp:      d.Get("dhcp").(bool),
+		EnableGatewayIp: !d.Get("no_gateway").(bool),
+		DnsNameServers:  cloudProjectNetworkSubnetDnsNameserversFromResource(d),
+		HostRoutes:      cloudProjectNetworkSubnetHostRoutesFromResource(d),
+	}
+	return updateCloudProjectNetworkSubnet(config.OVHClient, serviceName, region, openstackId, d.Id(), params)
+}
+
+// getCloudProjectNetworkPrivateOpenstackId returns the Openstack ID of a vRack private network in a region
+func getCloudProjectNetworkPrivateOpenstackId(config *Config, serviceName, networkId, region string) (string, error) {
+	network := &CloudProjectNetworkPrivateResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/network/private/%s", url.PathEscape(serviceName), url.PathEscape(networkId))
+	if err := config.OVHClient.Get(endpoint, network); err != nil {
+		return "", fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+	}
+
+	for _, r := range network.Regions {
+		if r.Region == region {
+			return r.OpenStackId, nil
+		}
+	}
+	return "", fmt.Errorf("private network %s is not in region %s", networkId, region)
+}
+
 func resourceCloudProjectNetworkPrivateSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	serviceName := d.Get("service_name").(string)
 	networkId := d.Get("network_id").(string)
+	// the region is required, it is only unknown when importing
+	importing := d.Get("region").(string) == ""
 
 	subnets := []*CloudProjectNetworkPrivatesResponse{}
 
@@ -224,6 +283,46 @@ func resourceCloudProjectNetworkPrivateSubnetRead(d *schema.ResourceData, meta i
 
 	d.Set("service_name", serviceName)
 	log.Printf("[DEBUG] Read Public Cloud Private Network %v", r)
+
+	// The DNS servers and host routes are only returned by the regional subnet endpoint:
+	// only read them when they are managed, or when importing as the region isn't known yet
+	_, dnsOk := d.GetOk("dns_nameservers")
+	_, routesOk := d.GetOk("host_routes")
+	if dnsOk || routesOk || importing {
+		return readCloudProjectNetworkPrivateSubnetRegionalAttributes(d, config, serviceName, networkId)
+	}
+	return nil
+}
+
+func readCloudProjectNetworkPrivateSubnetRegionalAttributes(d *schema.ResourceData, config *Config, serviceName, networkId string) error {
+	region := d.Get("region").(string)
+
+	network := &CloudProjectNetworkPrivateResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/network/private/%s", url.PathEscape(serviceName), url.PathEscape(networkId))
+	if err := config.OVHClient.Get(endpoint, network); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	openstackId := ""
+	for _, r := range network.Regions {
+		if r.Region == region {
+			openstackId = r.OpenStackId
+		}
+	}
+	if openstackId == "" {
+		log.Printf("[WARN] Private network %s is no longer in region %s, removing subnet %s from state", networkId, region, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	subnet := &CloudProjectNetworkSubnetResponse{}
+	endpoint = cloudProjectNetworkSubnetEndpoint(serviceName, region, openstackId, d.Id())
+	if err := config.OVHClient.Get(endpoint, subnet); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+	d.Set("dns_nameservers", subnet.DnsNameServers)
+	d.Set("host_routes", cloudProjectNetworkSubnetHostRoutesToMap(subnet.HostRoutes))
+
 	return nil
 }
 
@@ -257,6 +356,18 @@ func resourceCloudProjectNetworkPrivateSubnetValidateIP(v interface{}, k string)
 	return
 }
 
+// resourceCloudProjectNetworkPrivateSubnetValidateIPv4Network only accepts IPv4 networks,
+// which are the only ones handled by the vRack endpoint
+func resourceCloudProjectNetworkPrivateSubnetValidateIPv4Network(v interface{}, k string) (ws []string, errors []error) {
+	if ws, errors = resourceCloudProjectNetworkPrivateSubnetValidateNetwork(v, k); len(errors) > 0 {
+		return
+	}
+	if cloudProjectNetworkSubnetIpVersion(v.(string)) != 4 {
+		errors = append(errors, fmt.Errorf("%q must be an IPv4 network, use ovh_cloud_project_network_private_subnet_v2 for IPv6 subnets", k))
+	}
+	return
+}
+
 func resourceCloudProjectNetworkPrivateSubnetValidateNetwork(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	_, _, err := net.ParseCIDR(value)
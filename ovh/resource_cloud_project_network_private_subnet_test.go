@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 var testAccCloudProjectNetworkPrivateSubnetConfig_attachVrack = `
@@ -60,6 +61,42 @@ resource "ovh_cloud_project_network_private_subnet" "subnet" {
 }
 `
 
+var testAccCloudProjectNetworkPrivateSubnetConfig_updated = `
+%s
+
+resource "ovh_cloud_project_network_private_subnet" "subnet" {
+  service_name = ovh_cloud_project_network_private.network.service_name
+  network_id = ovh_cloud_project_network_private.network.id
+
+  # whatever region, for test purpose
+  region     = element(tolist(sort(data.ovh_cloud_project_regions.regions.names)), 0)
+  start      = "192.168.168.100"
+  end        = "192.168.168.200"
+  network    = "192.168.168.0/24"
+  dhcp       = false
+  no_gateway = false
+
+  dns_nameservers = ["192.168.168.2"]
+
+  host_routes {
+    destination = "10.10.0.0/16"
+    next_hop    = "192.168.168.254"
+  }
+}
+`
+
+func Test_resourceCloudProjectNetworkPrivateSubnetValidateIPv4Network(t *testing.T) {
+	if _, errs := resourceCloudProjectNetworkPrivateSubnetValidateIPv4Network("192.168.168.0/24", "network"); len(errs) > 0 {
+		t.Errorf("unexpected errors for an IPv4 network: %v", errs)
+	}
+	if _, errs := resourceCloudProjectNetworkPrivateSubnetValidateIPv4Network("fd00:1::/64", "network"); len(errs) != 1 {
+		t.Errorf("expected an error for an IPv6 network, got %v", errs)
+	}
+	if _, errs := resourceCloudProjectNetworkPrivateSubnetValidateIPv4Network("192.168.168.0", "network"); len(errs) != 1 {
+		t.Errorf("expected an error for an invalid network, got %v", errs)
+	}
+}
+
 func testAccCloudProjectNetworkPrivateSubnetConfig(config string) string {
 	attachVrack := fmt.Sprintf(
 		testAccCloudProjectNetworkPrivateSubnetConfig_attachVrack,
@@ -99,6 +136,19 @@ func TestAccCloudProjectNetworkPrivateSubnet_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("ovh_cloud_project_network_private_subnet.subnet", "network", "192.168.168.0/24"),
 				),
 			},
+			{
+				Config: testAccCloudProjectNetworkPrivateSubnetConfig(testAccCloudProjectNetworkPrivateSubnetConfig_updated),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("ovh_cloud_project_network_private_subnet.subnet", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_network_private_subnet.subnet", "dhcp", "false"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_network_private_subnet.subnet", "dns_nameservers.0", "192.168.168.2"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_network_private_subnet.subnet", "host_routes.0.next_hop", "192.168.168.254"),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,311 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceCloudProjectNetworkPrivateSubnetV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudProjectNetworkPrivateSubnetV2Create,
+		Read:   resourceCloudProjectNetworkPrivateSubnetV2Read,
+		Update: resourceCloudProjectNetworkPrivateSubnetV2Update,
+		Delete: resourceCloudProjectNetworkPrivateSubnetV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudProjectNetworkPrivateSubnetV2ImportState,
+		},
+
+		CustomizeDiff: resourceCloudProjectNetworkPrivateSubnetV2CustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+				Description: "Service name of the resource representing the id of the cloud project.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Region of the subnet",
+			},
+			"network_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Openstack ID of the private network in the region",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Name of the subnet",
+			},
+			"cidr": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "IPv4 or IPv6 network of the subnet",
+				ValidateFunc: resourceCloudProjectNetworkPrivateSubnetValidateNetwork,
+			},
+			"dhcp": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enable DHCP in the subnet",
+			},
+			"enable_gateway_ip": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Set a gateway IP in the subnet",
+			},
+			"gateway_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Gateway IP of the subnet, the first IP of the CIDR by default",
+				ValidateFunc: resourceCloudProjectNetworkPrivateSubnetValidateIP,
+			},
+			"allocation_pools": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Ranges of IPs allocated to the ports of the subnet, the whole CIDR but the gateway by default",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							Description:  "First IP of the range",
+							ValidateFunc: resourceCloudProjectNetworkPrivateSubnetValidateIP,
+						},
+						"end": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							Description:  "Last IP of the range",
+							ValidateFunc: resourceCloudProjectNetworkPrivateSubnetValidateIP,
+						},
+					},
+				},
+			},
+			"dns_nameservers": cloudProjectNetworkSubnetDnsNameserversSchema(),
+			"host_routes":     cloudProjectNetworkSubnetHostRoutesSchema(),
+
+			// computed
+			"ip_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "IP version of the subnet",
+			},
+		},
+	}
+}
+
+// cloudProjectNetworkSubnetDnsNameserversSchema is shared by the subnet resources
+func cloudProjectNetworkSubnetDnsNameserversSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		Description: "DNS nameservers of the subnet, the OVHcloud resolvers by default",
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: resourceCloudProjectNetworkPrivateSubnetValidateIP,
+		},
+	}
+}
+
+// cloudProjectNetworkSubnetHostRoutesSchema is shared by the subnet resources
+func cloudProjectNetworkSubnetHostRoutesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Static routes pushed to the instances of the subnet by DHCP",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"destination": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Network reached through the next hop",
+					ValidateFunc: resourceCloudProjectNetworkPrivateSubnetValidateNetwork,
+				},
+				"next_hop": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "IP of the subnet routing the destination",
+					ValidateFunc: resourceCloudProjectNetworkPrivateSubnetValidateIP,
+				},
+			},
+		},
+	}
+}
+
+func resourceCloudProjectNetworkPrivateSubnetV2ImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 4)
+	if len(splitId) != 4 {
+		return nil, fmt.Errorf("import Id is not service_name/region/network_id/subnet_id formatted")
+	}
+	d.SetId(splitId[3])
+	d.Set("service_name", splitId[0])
+	d.Set("region", splitId[1])
+	d.Set("network_id", splitId[2])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceCloudProjectNetworkPrivateSubnetV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	region := d.Get("region").(string)
+	networkId := d.Get("network_id").(string)
+
+	params := (&CloudProjectNetworkSubnetCreateOpts{}).FromResource(d)
+	endpoint := cloudProjectNetworkSubnetEndpoint(serviceName, region, networkId)
+	res := &CloudProjectNetworkSubnetResponse{}
+
+	log.Printf("[DEBUG] Will create subnet in network %s: %+v", networkId, params)
+	if err := config.OVHClient.Post(endpoint, params, res); err != nil {
+		return fmt.Errorf("calling Post %s with params %+v:\n\t %w", endpoint, params, err)
+	}
+	d.SetId(res.Id)
+
+	return resourceCloudProjectNetworkPrivateSubnetV2Read(d, meta)
+}
+
+func resourceCloudProjectNetworkPrivateSubnetV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	region := d.Get("region").(string)
+	networkId := d.Get("network_id").(string)
+
+	endpoint := cloudProjectNetworkSubnetEndpoint(serviceName, region, networkId, d.Id())
+	res := &CloudProjectNetworkSubnetResponse{}
+
+	log.Printf("[DEBUG] Will read subnet %s of network %s", d.Id(), networkId)
+	if err := config.OVHClient.Get(endpoint, res); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	for k, v := range res.ToMap() {
+		d.Set(k, v)
+	}
+
+	log.Printf("[DEBUG] Read subnet: %+v", res)
+	return nil
+}
+
+func resourceCloudProjectNetworkPrivateSubnetV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	params := &CloudProjectNetworkSubnetUpdateOpts{
+		EnableDhcp:      d.Get("dhcp").(bool),
+		EnableGatewayIp: d.Get("enable_gateway_ip").(bool),
+		DnsNameServers:  cloudProjectNetworkSubnetDnsNameserversFromResource(d),
+		HostRoutes:      cloudProjectNetworkSubnetHostRoutesFromResource(d),
+	}
+	if params.EnableGatewayIp && d.HasChange("gateway_ip") {
+		params.GatewayIp = helpers.GetNilStringPointerFromData(d, "gateway_ip")
+	}
+
+	if err := updateCloudProjectNetworkSubnet(
+		config.OVHClient,
+		d.Get("service_name").(string),
+		d.Get("region").(string),
+		d.Get("network_id").(string),
+		d.Id(),
+		params,
+	); err != nil {
+		return err
+	}
+
+	return resourceCloudProjectNetworkPrivateSubnetV2Read(d, meta)
+}
+
+func resourceCloudProjectNetworkPrivateSubnetV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	region := d.Get("region").(string)
+	networkId := d.Get("network_id").(string)
+
+	endpoint := cloudProjectNetworkSubnetEndpoint(serviceName, region, networkId, d.Id())
+
+	log.Printf("[DEBUG] Will delete subnet %s of network %s", d.Id(), networkId)
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudProjectNetworkPrivateSubnetV2CustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if raw := d.GetRawConfig(); !raw.IsNull() && raw.IsKnown() {
+		if gatewayIp := raw.GetAttr("gateway_ip"); !gatewayIp.IsNull() && !d.Get("enable_gateway_ip").(bool) {
+			return fmt.Errorf("gateway_ip can't be set when enable_gateway_ip is false")
+		}
+	}
+
+	if !d.NewValueKnown("cidr") || !d.NewValueKnown("gateway_ip") || !d.NewValueKnown("allocation_pools") ||
+		!d.NewValueKnown("dns_nameservers") || !d.NewValueKnown("host_routes") {
+		return nil
+	}
+
+	var pools []CloudProjectNetworkSubnetAllocationPool
+	for _, v := range d.Get("allocation_pools").([]interface{}) {
+		pool := v.(map[string]interface{})
+		pools = append(pools, CloudProjectNetworkSubnetAllocationPool{Start: pool["start"].(string), End: pool["end"].(string)})
+	}
+
+	var dnsNameServers []string
+	for _, v := range d.Get("dns_nameservers").([]interface{}) {
+		dnsNameServers = append(dnsNameServers, v.(string))
+	}
+
+	var routes []CloudProjectNetworkSubnetHostRoute
+	for _, v := range d.Get("host_routes").([]interface{}) {
+		route := v.(map[string]interface{})
+		routes = append(routes, CloudProjectNetworkSubnetHostRoute{Destination: route["destination"].(string), NextHop: route["next_hop"].(string)})
+	}
+
+	return validateCloudProjectNetworkSubnetAddresses(d.Get("cidr").(string), d.Get("gateway_ip").(string), pools, dnsNameServers, routes)
+}
+
+// cloudProjectNetworkSubnetEndpoint returns the regional endpoint of the subnets of a network,
+// or of one of them
+func cloudProjectNetworkSubnetEndpoint(serviceName, region, networkId string, path ...string) string {
+	endpoint := fmt.Sprintf("/cloud/project/%s/region/%s/network/%s/subnet",
+		url.PathEscape(serviceName),
+		url.PathEscape(region),
+		url.PathEscape(networkId))
+	for _, p := range path {
+		endpoint += "/" + url.PathEscape(p)
+	}
+	return endpoint
+}
+
+func updateCloudProjectNetworkSubnet(c *ovh.Client, serviceName, region, networkId, subnetId string, params *CloudProjectNetworkSubnetUpdateOpts) error {
+	endpoint := cloudProjectNetworkSubnetEndpoint(serviceName, region, networkId, subnetId)
+
+	log.Printf("[DEBUG] Will update subnet %s of network %s: %+v", subnetId, networkId, params)
+	if err := c.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %+v:\n\t %w", endpoint, params, err)
+	}
+	return nil
+}
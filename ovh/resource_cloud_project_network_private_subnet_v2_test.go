@@ -0,0 +1,167 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func Test_cloudProjectNetworkSubnetIpVersion(t *testing.T) {
+	if got := cloudProjectNetworkSubnetIpVersion("10.0.0.0/16"); got != 4 {
+		t.Errorf("cloudProjectNetworkSubnetIpVersion(10.0.0.0/16) = %d, want 4", got)
+	}
+	if got := cloudProjectNetworkSubnetIpVersion("fd00:1::/64"); got != 6 {
+		t.Errorf("cloudProjectNetworkSubnetIpVersion(fd00:1::/64) = %d, want 6", got)
+	}
+}
+
+func Test_validateCloudProjectNetworkSubnetAddresses(t *testing.T) {
+	type pools = []CloudProjectNetworkSubnetAllocationPool
+	type routes = []CloudProjectNetworkSubnetHostRoute
+
+	tests := []struct {
+		name      string
+		cidr      string
+		gatewayIp string
+		pools     pools
+		dns       []string
+		routes    routes
+		wantErr   bool
+	}{
+		{
+			name:      "ipv4",
+			cidr:      "10.0.0.0/24",
+			gatewayIp: "10.0.0.1",
+			pools:     pools{{Start: "10.0.0.10", End: "10.0.0.200"}},
+			dns:       []string{"1.1.1.1"},
+			routes:    routes{{Destination: "192.168.0.0/16", NextHop: "10.0.0.254"}},
+		},
+		{
+			name:      "ipv6",
+			cidr:      "fd00:1::/64",
+			gatewayIp: "fd00:1::1",
+			pools:     pools{{Start: "fd00:1::10", End: "fd00:1::ffff"}},
+			dns:       []string{"2001:4860:4860::8888"},
+			routes:    routes{{Destination: "fd00:2::/64", NextHop: "fd00:1::fe"}},
+		},
+		{name: "gateway outside", cidr: "10.0.0.0/24", gatewayIp: "10.0.1.1", wantErr: true},
+		{name: "pool outside", cidr: "10.0.0.0/24", pools: pools{{Start: "10.0.0.10", End: "10.0.1.10"}}, wantErr: true},
+		{name: "reversed pool", cidr: "10.0.0.0/24", pools: pools{{Start: "10.0.0.200", End: "10.0.0.10"}}, wantErr: true},
+		{name: "ipv4 dns in ipv6 subnet", cidr: "fd00:1::/64", dns: []string{"1.1.1.1"}, wantErr: true},
+		{name: "ipv6 route in ipv4 subnet", cidr: "10.0.0.0/24", routes: routes{{Destination: "fd00:2::/64", NextHop: "10.0.0.254"}}, wantErr: true},
+		{name: "next hop outside", cidr: "10.0.0.0/24", routes: routes{{Destination: "192.168.0.0/16", NextHop: "10.0.1.254"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCloudProjectNetworkSubnetAddresses(tt.cidr, tt.gatewayIp, tt.pools, tt.dns, tt.routes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateCloudProjectNetworkSubnetAddresses() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+var testAccCloudProjectNetworkPrivateSubnetV2Config = `
+resource "ovh_vrack_cloudproject" "attach" {
+  service_name = "%s"
+  project_id   = "%s"
+}
+
+resource "ovh_cloud_project_network_private" "network" {
+  service_name = ovh_vrack_cloudproject.attach.project_id
+  vlan_id      = %d
+  name         = "%s"
+  regions      = ["%s"]
+}
+
+resource "ovh_cloud_project_network_private_subnet_v2" "ipv4" {
+  service_name    = ovh_cloud_project_network_private.network.service_name
+  region          = "%s"
+  network_id      = tolist(ovh_cloud_project_network_private.network.regions_attributes[*].openstackid)[0]
+  name            = "ipv4"
+  cidr            = "10.0.0.0/24"
+  dhcp            = %t
+  dns_nameservers = ["%s"]
+
+  allocation_pools {
+    start = "10.0.0.10"
+    end   = "10.0.0.200"
+  }
+}
+
+resource "ovh_cloud_project_network_private_subnet_v2" "ipv6" {
+  service_name = ovh_cloud_project_network_private_subnet_v2.ipv4.service_name
+  region       = ovh_cloud_project_network_private_subnet_v2.ipv4.region
+  network_id   = ovh_cloud_project_network_private_subnet_v2.ipv4.network_id
+  name         = "ipv6"
+  cidr         = "fd00:1::/64"
+}
+`
+
+func TestAccCloudProjectNetworkPrivateSubnetV2_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	region := os.Getenv("OVH_CLOUD_PROJECT_REGION_TEST")
+	vlanId := acctest.RandIntRange(100, 200)
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	config := func(dhcp bool, dns string) string {
+		return fmt.Sprintf(
+			testAccCloudProjectNetworkPrivateSubnetV2Config,
+			os.Getenv("OVH_VRACK_SERVICE_TEST"),
+			serviceName,
+			vlanId,
+			name,
+			region,
+			region,
+			dhcp,
+			dns,
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccCheckcCloudProjectNetworkPrivateSubnetPreCheck(t)
+			testAccPreCheckCloudRegion(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config(true, "10.0.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_network_private_subnet_v2.ipv4", "ip_version", "4"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_network_private_subnet_v2.ipv4", "dhcp", "true"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_network_private_subnet_v2.ipv4", "gateway_ip", "10.0.0.1"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_network_private_subnet_v2.ipv4", "allocation_pools.0.start", "10.0.0.10"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_network_private_subnet_v2.ipv6", "ip_version", "6"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_network_private_subnet_v2.ipv6", "cidr", "fd00:1::/64"),
+				),
+			},
+			{
+				Config: config(false, "10.0.0.3"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("ovh_cloud_project_network_private_subnet_v2.ipv4", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_network_private_subnet_v2.ipv4", "dhcp", "false"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_network_private_subnet_v2.ipv4", "dns_nameservers.0", "10.0.0.3"),
+				),
+			},
+			{
+				ResourceName:      "ovh_cloud_project_network_private_subnet_v2.ipv4",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					subnet := s.RootModule().Resources["ovh_cloud_project_network_private_subnet_v2.ipv4"]
+					return fmt.Sprintf("%s/%s/%s/%s", serviceName, region, subnet.Primary.Attributes["network_id"], subnet.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
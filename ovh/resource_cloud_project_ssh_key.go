@@ -0,0 +1,131 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceCloudProjectSshKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudProjectSshKeyCreate,
+		Read:   resourceCloudProjectSshKeyRead,
+		Delete: resourceCloudProjectSshKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudProjectSshKeyImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+				Description: "Service name of the resource representing the ID of the cloud project.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the SSH key, used by the instances to reference it",
+			},
+			"public_key": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "SSH public key in the authorized_keys format",
+				ValidateFunc:     helpers.ValidateSshPublicKey,
+				DiffSuppressFunc: helpers.SuppressSshPublicKeyDiff,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Region the SSH key is restricted to, all the regions of the project if not set",
+			},
+
+			// computed
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Fingerprint of the SSH key",
+			},
+			"regions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Regions the SSH key is available in",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceCloudProjectSshKeyImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("import Id is not service_name/ssh_key_id formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceCloudProjectSshKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	params := (&CloudProjectSshKeyCreateOpts{}).FromResource(d)
+	endpoint := fmt.Sprintf("/cloud/project/%s/sshkey", url.PathEscape(serviceName))
+	res := &CloudProjectSshKeyResponse{}
+
+	log.Printf("[DEBUG] Will create SSH key %s in project %s", params.Name, serviceName)
+	if err := config.OVHClient.Post(endpoint, params, res); err != nil {
+		return fmt.Errorf("calling Post %s with params %+v:\n\t %w", endpoint, params, err)
+	}
+	d.SetId(res.Id)
+
+	return resourceCloudProjectSshKeyRead(d, meta)
+}
+
+func resourceCloudProjectSshKeyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/sshkey/%s", url.PathEscape(serviceName), url.PathEscape(d.Id()))
+	res := &CloudProjectSshKeyResponse{}
+
+	log.Printf("[DEBUG] Will read SSH key %s of project %s", d.Id(), serviceName)
+	if err := config.OVHClient.Get(endpoint, res); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	for k, v := range res.ToMap() {
+		d.Set(k, v)
+	}
+
+	log.Printf("[DEBUG] Read SSH key: %+v", res)
+	return nil
+}
+
+func resourceCloudProjectSshKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/sshkey/%s", url.PathEscape(serviceName), url.PathEscape(d.Id()))
+
+	log.Printf("[DEBUG] Will delete SSH key %s of project %s", d.Id(), serviceName)
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,107 @@
+package ovh
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"golang.org/x/crypto/ssh"
+)
+
+func init() {
+	resource.AddTestSweepers("ovh_cloud_project_ssh_key", &resource.Sweeper{
+		Name: "ovh_cloud_project_ssh_key",
+		F:    testSweepCloudProjectSshKey,
+	})
+}
+
+func testSweepCloudProjectSshKey(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	if serviceName == "" {
+		log.Print("[DEBUG] OVH_CLOUD_PROJECT_SERVICE_TEST is not set. No SSH key to sweep")
+		return nil
+	}
+
+	keys := make([]CloudProjectSshKeyResponse, 0)
+	endpoint := fmt.Sprintf("/cloud/project/%s/sshkey", serviceName)
+	if err := client.Get(endpoint, &keys); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	for _, key := range keys {
+		if !strings.HasPrefix(key.Name, test_prefix) {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting SSH key %s/%s", key.Name, key.Id)
+		if err := client.Delete(fmt.Sprintf("%s/%s", endpoint, key.Id), nil); err != nil {
+			return fmt.Errorf("Error deleting SSH key %s:\n\t %q", key.Id, err)
+		}
+	}
+	return nil
+}
+
+// testAccGenerateSshPublicKey returns a new Ed25519 public key in the authorized_keys format,
+// the API refusing the same key twice
+func testAccGenerateSshPublicKey(t *testing.T) string {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generating SSH key: %s", err)
+	}
+	sshPub, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		t.Fatalf("generating SSH key: %s", err)
+	}
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPub)))
+}
+
+var testAccCloudProjectSshKeyConfig = `
+resource "ovh_cloud_project_ssh_key" "key" {
+  service_name = "%s"
+  name         = "%s"
+  public_key   = "%s"
+  region       = "%s"
+}
+`
+
+func TestAccCloudProjectSshKey_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	region := os.Getenv("OVH_CLOUD_PROJECT_REGION_TEST")
+	name := acctest.RandomWithPrefix(test_prefix)
+	key := testAccGenerateSshPublicKey(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckCloudRegion(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudProjectSshKeyConfig, serviceName, name, key, region),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_ssh_key.key", "name", name),
+					resource.TestCheckResourceAttr("ovh_cloud_project_ssh_key.key", "public_key", key),
+					resource.TestCheckResourceAttr("ovh_cloud_project_ssh_key.key", "regions.0", region),
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_ssh_key.key", "fingerprint"),
+				),
+			},
+			{
+				ResourceName:        "ovh_cloud_project_ssh_key.key",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: serviceName + "/",
+				// the API doesn't tell whether the key was restricted to a region
+				ImportStateVerifyIgnore: []string{"region"},
+			},
+		},
+	})
+}
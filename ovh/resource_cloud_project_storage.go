@@ -0,0 +1,311 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+const (
+	cloudProjectStorageVersioningEnabled   = "enabled"
+	cloudProjectStorageVersioningDisabled  = "disabled"
+	cloudProjectStorageVersioningSuspended = "suspended"
+	cloudProjectStorageObjectLockEnabled   = "enabled"
+)
+
+var cloudProjectStorageNameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+
+func resourceCloudProjectStorage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudProjectStorageCreate,
+		Read:   resourceCloudProjectStorageRead,
+		Update: resourceCloudProjectStorageUpdate,
+		Delete: resourceCloudProjectStorageDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudProjectStorageImportState,
+		},
+
+		CustomizeDiff: resourceCloudProjectStorageCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+				Description: "Service name of the resource representing the ID of the cloud project.",
+			},
+			"region_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Region name.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The S3 storage container's name.",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if err := validateCloudProjectStorageName(v.(string)); err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"owner_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the cloud project user owning the container.",
+			},
+			"versioning": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Versioning configuration of the container.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Versioning status: enabled, disabled or suspended.",
+							ValidateFunc: helpers.ValidateEnum([]string{
+								cloudProjectStorageVersioningEnabled,
+								cloudProjectStorageVersioningDisabled,
+								cloudProjectStorageVersioningSuspended,
+							}),
+						},
+					},
+				},
+			},
+			"encryption": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Server-side encryption configuration of the container.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sse_algorithm": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Encryption algorithm of the objects: AES256 or plaintext.",
+							ValidateFunc: helpers.ValidateEnum([]string{"AES256", "plaintext"}),
+						},
+					},
+				},
+			},
+			"object_lock": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Object lock configuration of the container. It requires the versioning to be enabled.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							Description:  "Object lock status: enabled or disabled. It can only be set at creation.",
+							ValidateFunc: helpers.ValidateEnum([]string{cloudProjectStorageObjectLockEnabled, "disabled"}),
+						},
+						"rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Default retention of the objects.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"mode": {
+										Type:         schema.TypeString,
+										Required:     true,
+										Description:  "Retention mode: compliance or governance.",
+										ValidateFunc: helpers.ValidateEnum([]string{"compliance", "governance"}),
+									},
+									"period": {
+										Type:         schema.TypeString,
+										Required:     true,
+										Description:  "Retention period, as an ISO 8601 duration, e.g. P30D or P1Y.",
+										ValidateFunc: helpers.ValidateRFC3339Duration,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Tags of the container.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			// Computed
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date of the container.",
+			},
+			"objects_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of objects in the container.",
+			},
+			"objects_size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total size of the objects in the container, in bytes.",
+			},
+			"virtual_host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Virtual host of the container.",
+			},
+		},
+	}
+}
+
+func resourceCloudProjectStorageImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("import Id is not service_name/region_name/name formatted")
+	}
+	d.SetId(splitId[2])
+	d.Set("service_name", splitId[0])
+	d.Set("region_name", splitId[1])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceCloudProjectStorageCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	params := (&CloudProjectStorageCreateOpts{}).FromResource(d)
+	endpoint := fmt.Sprintf("/cloud/project/%s/region/%s/storage",
+		url.PathEscape(serviceName),
+		url.PathEscape(regionName))
+	res := &CloudProjectStorageResponse{}
+
+	log.Printf("[DEBUG] Will create storage container: %s", params)
+	if err := config.OVHClient.Post(endpoint, params, res); err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %w", endpoint, params, err)
+	}
+
+	d.SetId(params.Name)
+
+	return resourceCloudProjectStorageRead(d, meta)
+}
+
+func resourceCloudProjectStorageRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	endpoint := cloudProjectStorageEndpoint(serviceName, regionName, d.Id())
+	res := &CloudProjectStorageResponse{}
+
+	log.Printf("[DEBUG] Will read storage container %s in region %s", d.Id(), regionName)
+	if err := config.OVHClient.Get(endpoint, res); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	for k, v := range res.ToMap() {
+		d.Set(k, v)
+	}
+
+	log.Printf("[DEBUG] Read storage container: %+v", res)
+	return nil
+}
+
+func resourceCloudProjectStorageUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	params := (&CloudProjectStorageUpdateOpts{}).FromResource(d)
+	endpoint := cloudProjectStorageEndpoint(serviceName, regionName, d.Id())
+
+	log.Printf("[DEBUG] Will update storage container %s: %+v", d.Id(), params)
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %+v:\n\t %w", endpoint, params, err)
+	}
+
+	return resourceCloudProjectStorageRead(d, meta)
+}
+
+func resourceCloudProjectStorageDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	endpoint := cloudProjectStorageEndpoint(serviceName, regionName, d.Id())
+
+	log.Printf("[DEBUG] Will delete storage container %s in region %s", d.Id(), regionName)
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudProjectStorageCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	oldVersioning, newVersioning := d.GetChange("versioning.0.status")
+	return validateCloudProjectStorageVersioning(
+		oldVersioning.(string),
+		newVersioning.(string),
+		d.Get("object_lock.0.status").(string),
+	)
+}
+
+// validateCloudProjectStorageVersioning checks the versioning transition of a container:
+// once enabled it can only be suspended, and it must stay enabled when object lock is
+func validateCloudProjectStorageVersioning(oldStatus, newStatus, objectLockStatus string) error {
+	if objectLockStatus == cloudProjectStorageObjectLockEnabled && newStatus != cloudProjectStorageVersioningEnabled {
+		return fmt.Errorf("object_lock requires versioning to be %s", cloudProjectStorageVersioningEnabled)
+	}
+
+	if newStatus == cloudProjectStorageVersioningDisabled &&
+		(oldStatus == cloudProjectStorageVersioningEnabled || oldStatus == cloudProjectStorageVersioningSuspended) {
+		return fmt.Errorf("versioning can't be disabled once it has been enabled, it can only be %s", cloudProjectStorageVersioningSuspended)
+	}
+
+	return nil
+}
+
+// validateCloudProjectStorageName checks the name of a container follows the S3 bucket naming rules
+func validateCloudProjectStorageName(name string) error {
+	if !cloudProjectStorageNameRegexp.MatchString(name) {
+		return fmt.Errorf("%q must be 3 to 63 characters long, made of lowercase letters, digits, dots and hyphens, and start and end with a letter or a digit", name)
+	}
+	if strings.Contains(name, "..") {
+		return fmt.Errorf("%q must not contain two adjacent dots", name)
+	}
+	if net.ParseIP(name) != nil {
+		return fmt.Errorf("%q must not be formatted as an IP address", name)
+	}
+	return nil
+}
+
+func cloudProjectStorageEndpoint(serviceName, regionName, name string) string {
+	return fmt.Sprintf("/cloud/project/%s/region/%s/storage/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(regionName),
+		url.PathEscape(name))
+}
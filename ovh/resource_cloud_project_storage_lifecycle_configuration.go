@@ -0,0 +1,239 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceCloudProjectStorageLifecycleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudProjectStorageLifecycleConfigurationUpdate,
+		Read:   resourceCloudProjectStorageLifecycleConfigurationRead,
+		Update: resourceCloudProjectStorageLifecycleConfigurationUpdate,
+		Delete: resourceCloudProjectStorageLifecycleConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudProjectStorageConfigurationImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+				Description: "Service name of the resource representing the ID of the cloud project.",
+			},
+			"region_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Region name.",
+			},
+			"container_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The S3 storage container's name.",
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Lifecycle rules of the container.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Unique identifier of the rule.",
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "enabled",
+							Description:  "Status of the rule: enabled or disabled.",
+							ValidateFunc: helpers.ValidateEnum([]string{"enabled", "disabled"}),
+						},
+						"filter": cloudProjectStorageRuleFilterSchema(true),
+						"expiration": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Expiration of the current version of the objects.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: "Number of days after their creation the objects expire.",
+									},
+									"date": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Date the objects expire, in ISO 8601 format.",
+									},
+									"expired_object_delete_marker": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Remove the delete markers without noncurrent versions.",
+									},
+								},
+							},
+						},
+						"noncurrent_version_expiration": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Expiration of the noncurrent versions of the objects.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"noncurrent_days": {
+										Type:        schema.TypeInt,
+										Required:    true,
+										Description: "Number of days after they become noncurrent the versions expire.",
+									},
+									"newer_noncurrent_versions": {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: "Number of noncurrent versions to keep.",
+									},
+								},
+							},
+						},
+						"abort_incomplete_multipart_upload": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Abort of the incomplete multipart uploads.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days_after_initiation": {
+										Type:        schema.TypeInt,
+										Required:    true,
+										Description: "Number of days after their initiation the uploads are aborted.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// cloudProjectStorageRuleFilterSchema returns the filter of a lifecycle or replication rule,
+// the object size conditions are only supported by lifecycle rules
+func cloudProjectStorageRuleFilterSchema(withObjectSize bool) *schema.Schema {
+	filter := map[string]*schema.Schema{
+		"prefix": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Prefix of the objects the rule applies to.",
+		},
+		"tags": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Tags of the objects the rule applies to.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+
+	if withObjectSize {
+		filter["object_size_greater_than"] = &schema.Schema{
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Minimum size in bytes of the objects the rule applies to.",
+		}
+		filter["object_size_less_than"] = &schema.Schema{
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Maximum size in bytes of the objects the rule applies to.",
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Objects the rule applies to, all of them if omitted.",
+		Elem:        &schema.Resource{Schema: filter},
+	}
+}
+
+// resourceCloudProjectStorageConfigurationImportState imports the configurations
+// attached to a container, identified by service_name/region_name/container_name
+func resourceCloudProjectStorageConfigurationImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("import Id is not service_name/region_name/container_name formatted")
+	}
+	d.SetId(splitId[2])
+	d.Set("service_name", splitId[0])
+	d.Set("region_name", splitId[1])
+	d.Set("container_name", splitId[2])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceCloudProjectStorageLifecycleConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+	containerName := d.Get("container_name").(string)
+
+	endpoint := cloudProjectStorageEndpoint(serviceName, regionName, containerName) + "/lifecycle"
+	params := (&CloudProjectStorageLifecycle{}).FromResource(d)
+
+	log.Printf("[DEBUG] Will set the lifecycle configuration of storage container %s: %+v", containerName, params)
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %+v:\n\t %w", endpoint, params, err)
+	}
+
+	d.SetId(containerName)
+
+	return resourceCloudProjectStorageLifecycleConfigurationRead(d, meta)
+}
+
+func resourceCloudProjectStorageLifecycleConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	endpoint := cloudProjectStorageEndpoint(serviceName, regionName, d.Id()) + "/lifecycle"
+	res := &CloudProjectStorageLifecycle{}
+
+	log.Printf("[DEBUG] Will read the lifecycle configuration of storage container %s", d.Id())
+	if err := config.OVHClient.Get(endpoint, res); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("container_name", d.Id())
+	d.Set("rule", res.ToMap())
+
+	log.Printf("[DEBUG] Read lifecycle configuration: %+v", res)
+	return nil
+}
+
+func resourceCloudProjectStorageLifecycleConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	endpoint := cloudProjectStorageEndpoint(serviceName, regionName, d.Id()) + "/lifecycle"
+
+	log.Printf("[DEBUG] Will delete the lifecycle configuration of storage container %s", d.Id())
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,89 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testAccCloudProjectStorageLifecycleConfigurationConfig = `
+resource "ovh_cloud_project_storage" "storage" {
+  service_name = "%s"
+  region_name  = "%s"
+  name         = "%s"
+
+  versioning {
+    status = "enabled"
+  }
+}
+
+resource "ovh_cloud_project_storage_lifecycle_configuration" "lifecycle" {
+  service_name   = ovh_cloud_project_storage.storage.service_name
+  region_name    = ovh_cloud_project_storage.storage.region_name
+  container_name = ovh_cloud_project_storage.storage.name
+
+  rule {
+    id = "logs"
+
+    filter {
+      prefix = "logs/"
+    }
+
+    expiration {
+      days = %d
+    }
+
+    noncurrent_version_expiration {
+      noncurrent_days = 7
+    }
+  }
+
+  rule {
+    id = "uploads"
+
+    abort_incomplete_multipart_upload {
+      days_after_initiation = 1
+    }
+  }
+}
+`
+
+func TestAccCloudProjectStorageLifecycleConfiguration_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	regionName := os.Getenv("OVH_CLOUD_PROJECT_STORAGE_REGION_TEST")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloudStorage(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudProjectStorageLifecycleConfigurationConfig, serviceName, regionName, name, 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_storage_lifecycle_configuration.lifecycle", "rule.#", "2"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_storage_lifecycle_configuration.lifecycle", "rule.0.status", "enabled"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_storage_lifecycle_configuration.lifecycle", "rule.0.filter.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_storage_lifecycle_configuration.lifecycle", "rule.0.expiration.0.days", "30"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_storage_lifecycle_configuration.lifecycle", "rule.1.abort_incomplete_multipart_upload.0.days_after_initiation", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCloudProjectStorageLifecycleConfigurationConfig, serviceName, regionName, name, 90),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_storage_lifecycle_configuration.lifecycle", "rule.0.expiration.0.days", "90"),
+				),
+			},
+			{
+				ResourceName:        "ovh_cloud_project_storage_lifecycle_configuration.lifecycle",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: serviceName + "/" + regionName + "/",
+			},
+		},
+	})
+}
@@ -0,0 +1,177 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceCloudProjectStorageReplicationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudProjectStorageReplicationConfigurationUpdate,
+		Read:   resourceCloudProjectStorageReplicationConfigurationRead,
+		Update: resourceCloudProjectStorageReplicationConfigurationUpdate,
+		Delete: resourceCloudProjectStorageReplicationConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudProjectStorageConfigurationImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+				Description: "Service name of the resource representing the ID of the cloud project.",
+			},
+			"region_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Region name.",
+			},
+			"container_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The S3 storage container's name. Its versioning must be enabled.",
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Replication rules of the container.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Unique identifier of the rule.",
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "enabled",
+							Description:  "Status of the rule: enabled or disabled.",
+							ValidateFunc: helpers.ValidateEnum([]string{"enabled", "disabled"}),
+						},
+						"priority": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     1,
+							Description: "Priority of the rule when several rules apply to an object, the highest wins.",
+						},
+						"destination": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MaxItems:    1,
+							Description: "Container the objects are replicated to. Its versioning must be enabled.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Name of the destination container.",
+									},
+									"region": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Region of the destination container.",
+									},
+									"storage_class": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Computed:    true,
+										Description: "Storage class of the replicated objects.",
+									},
+									"remove_on_main_bucket_deletion": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Delete the destination container when the source container is deleted.",
+									},
+								},
+							},
+						},
+						"filter": cloudProjectStorageRuleFilterSchema(false),
+						"delete_marker_replication": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "disabled",
+							Description:  "Replication of the delete markers: enabled or disabled.",
+							ValidateFunc: helpers.ValidateEnum([]string{"enabled", "disabled"}),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCloudProjectStorageReplicationConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+	containerName := d.Get("container_name").(string)
+
+	endpoint := cloudProjectStorageEndpoint(serviceName, regionName, containerName)
+	params := &CloudProjectStorageUpdateOpts{
+		Replication: (&CloudProjectStorageReplication{}).FromResource(d),
+	}
+
+	log.Printf("[DEBUG] Will set the replication configuration of storage container %s: %+v", containerName, params.Replication)
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Put %s with params %+v:\n\t %w", endpoint, params, err)
+	}
+
+	d.SetId(containerName)
+
+	return resourceCloudProjectStorageReplicationConfigurationRead(d, meta)
+}
+
+func resourceCloudProjectStorageReplicationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	endpoint := cloudProjectStorageEndpoint(serviceName, regionName, d.Id())
+	res := &CloudProjectStorageResponse{}
+
+	log.Printf("[DEBUG] Will read the replication configuration of storage container %s", d.Id())
+	if err := config.OVHClient.Get(endpoint, res); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	rules := res.ReplicationRulesToMap()
+	if len(rules) == 0 {
+		log.Printf("[WARN] storage container %s has no replication rule anymore, removing it from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("container_name", d.Id())
+	d.Set("rule", rules)
+
+	log.Printf("[DEBUG] Read replication configuration: %+v", res.Replication)
+	return nil
+}
+
+func resourceCloudProjectStorageReplicationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	regionName := d.Get("region_name").(string)
+
+	endpoint := cloudProjectStorageEndpoint(serviceName, regionName, d.Id())
+	params := &CloudProjectStorageUpdateOpts{
+		Replication: &CloudProjectStorageReplication{Rules: []CloudProjectStorageReplicationRule{}},
+	}
+
+	log.Printf("[DEBUG] Will delete the replication configuration of storage container %s", d.Id())
+	if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.SetId("")
+	return nil
+}
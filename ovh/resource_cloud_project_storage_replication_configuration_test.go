@@ -0,0 +1,90 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testAccCloudProjectStorageReplicationConfigurationConfig = `
+resource "ovh_cloud_project_storage" "source" {
+  service_name = "%s"
+  region_name  = "%s"
+  name         = "%s"
+
+  versioning {
+    status = "enabled"
+  }
+}
+
+resource "ovh_cloud_project_storage" "destination" {
+  service_name = ovh_cloud_project_storage.source.service_name
+  region_name  = ovh_cloud_project_storage.source.region_name
+  name         = "%s"
+
+  versioning {
+    status = "enabled"
+  }
+}
+
+resource "ovh_cloud_project_storage_replication_configuration" "replication" {
+  service_name   = ovh_cloud_project_storage.source.service_name
+  region_name    = ovh_cloud_project_storage.source.region_name
+  container_name = ovh_cloud_project_storage.source.name
+
+  rule {
+    id                        = "backup"
+    delete_marker_replication = "%s"
+
+    destination {
+      name   = ovh_cloud_project_storage.destination.name
+      region = ovh_cloud_project_storage.destination.region_name
+    }
+
+    filter {
+      prefix = "backup/"
+    }
+  }
+}
+`
+
+func TestAccCloudProjectStorageReplicationConfiguration_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	regionName := os.Getenv("OVH_CLOUD_PROJECT_STORAGE_REGION_TEST")
+	source := acctest.RandomWithPrefix(test_prefix)
+	destination := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloudStorage(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudProjectStorageReplicationConfigurationConfig, serviceName, regionName, source, destination, "disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_storage_replication_configuration.replication", "rule.#", "1"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_storage_replication_configuration.replication", "rule.0.status", "enabled"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_storage_replication_configuration.replication", "rule.0.destination.0.name", destination),
+					resource.TestCheckResourceAttr("ovh_cloud_project_storage_replication_configuration.replication", "rule.0.filter.0.prefix", "backup/"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_storage_replication_configuration.replication", "rule.0.delete_marker_replication", "disabled"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCloudProjectStorageReplicationConfigurationConfig, serviceName, regionName, source, destination, "enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_storage_replication_configuration.replication", "rule.0.delete_marker_replication", "enabled"),
+				),
+			},
+			{
+				ResourceName:        "ovh_cloud_project_storage_replication_configuration.replication",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: serviceName + "/" + regionName + "/",
+			},
+		},
+	})
+}
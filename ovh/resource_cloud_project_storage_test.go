@@ -0,0 +1,215 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("ovh_cloud_project_storage", &resource.Sweeper{
+		Name: "ovh_cloud_project_storage",
+		F:    testSweepCloudProjectStorage,
+	})
+}
+
+func testSweepCloudProjectStorage(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	regionName := os.Getenv("OVH_CLOUD_PROJECT_STORAGE_REGION_TEST")
+	if serviceName == "" || regionName == "" {
+		log.Print("[DEBUG] OVH_CLOUD_PROJECT_SERVICE_TEST or OVH_CLOUD_PROJECT_STORAGE_REGION_TEST is not set. No storage container to sweep")
+		return nil
+	}
+
+	containers := make([]CloudProjectStorageResponse, 0)
+	endpoint := fmt.Sprintf("/cloud/project/%s/region/%s/storage", serviceName, regionName)
+	if err := client.Get(endpoint, &containers); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	for _, container := range containers {
+		if !strings.HasPrefix(container.Name, test_prefix) {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting storage container %s in region %s", container.Name, regionName)
+		if err := client.Delete(cloudProjectStorageEndpoint(serviceName, regionName, container.Name), nil); err != nil {
+			return fmt.Errorf("Error deleting storage container %s:\n\t %q", container.Name, err)
+		}
+	}
+	return nil
+}
+
+func Test_validateCloudProjectStorageName(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{name: "my-bucket", wantErr: false},
+		{name: "my.bucket.01", wantErr: false},
+		{name: "ab", wantErr: true},
+		{name: "My-Bucket", wantErr: true},
+		{name: "-bucket", wantErr: true},
+		{name: "bucket-", wantErr: true},
+		{name: "my..bucket", wantErr: true},
+		{name: "my_bucket", wantErr: true},
+		{name: "192.168.1.1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateCloudProjectStorageName(tt.name); (err != nil) != tt.wantErr {
+				t.Errorf("validateCloudProjectStorageName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_validateCloudProjectStorageVersioning(t *testing.T) {
+	tests := []struct {
+		name       string
+		oldStatus  string
+		newStatus  string
+		objectLock string
+		wantErr    bool
+	}{
+		{name: "create enabled", oldStatus: "", newStatus: "enabled", wantErr: false},
+		{name: "create without versioning", oldStatus: "", newStatus: "", wantErr: false},
+		{name: "enable", oldStatus: "disabled", newStatus: "enabled", wantErr: false},
+		{name: "suspend", oldStatus: "enabled", newStatus: "suspended", wantErr: false},
+		{name: "enable again", oldStatus: "suspended", newStatus: "enabled", wantErr: false},
+		{name: "disable enabled", oldStatus: "enabled", newStatus: "disabled", wantErr: true},
+		{name: "disable suspended", oldStatus: "suspended", newStatus: "disabled", wantErr: true},
+		{name: "object lock enabled", oldStatus: "", newStatus: "enabled", objectLock: "enabled", wantErr: false},
+		{name: "object lock without versioning", oldStatus: "", newStatus: "", objectLock: "enabled", wantErr: true},
+		{name: "object lock suspended", oldStatus: "enabled", newStatus: "suspended", objectLock: "enabled", wantErr: true},
+		{name: "object lock disabled", oldStatus: "", newStatus: "disabled", objectLock: "disabled", wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateCloudProjectStorageVersioning(tt.oldStatus, tt.newStatus, tt.objectLock); (err != nil) != tt.wantErr {
+				t.Errorf("validateCloudProjectStorageVersioning() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+var testAccCloudProjectStorageConfig = `
+resource "ovh_cloud_project_user" "user" {
+  service_name = "%s"
+  description  = "%s"
+  role_name    = "objectstore_operator"
+}
+
+resource "ovh_cloud_project_storage" "storage" {
+  service_name = ovh_cloud_project_user.user.service_name
+  region_name  = "%s"
+  name         = "%s"
+  owner_id     = ovh_cloud_project_user.user.id
+
+  versioning {
+    status = "%s"
+  }
+
+  encryption {
+    sse_algorithm = "AES256"
+  }
+
+  tags = {
+    env = "%s"
+  }
+}
+`
+
+func TestAccCloudProjectStorage_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	regionName := os.Getenv("OVH_CLOUD_PROJECT_STORAGE_REGION_TEST")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloudStorage(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudProjectStorageConfig, serviceName, name, regionName, name, "enabled", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_storage.storage", "name", name),
+					resource.TestCheckResourceAttr("ovh_cloud_project_storage.storage", "versioning.0.status", "enabled"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_storage.storage", "encryption.0.sse_algorithm", "AES256"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_storage.storage", "tags.env", "test"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_storage.storage", "objects_count", "0"),
+					resource.TestCheckResourceAttrPair("ovh_cloud_project_storage.storage", "owner_id", "ovh_cloud_project_user.user", "id"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_storage.storage", "virtual_host"),
+				),
+			},
+			{
+				// the versioning is suspended and the tags are updated in place
+				Config: fmt.Sprintf(testAccCloudProjectStorageConfig, serviceName, name, regionName, name, "suspended", "prod"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_storage.storage", "versioning.0.status", "suspended"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_storage.storage", "tags.env", "prod"),
+				),
+			},
+			{
+				ResourceName:        "ovh_cloud_project_storage.storage",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: serviceName + "/" + regionName + "/",
+			},
+		},
+	})
+}
+
+var testAccCloudProjectStorageObjectLockConfig = `
+resource "ovh_cloud_project_storage" "storage" {
+  service_name = "%s"
+  region_name  = "%s"
+  name         = "%s"
+
+  versioning {
+    status = "enabled"
+  }
+
+  object_lock {
+    status = "enabled"
+    rule {
+      mode   = "governance"
+      period = "P1D"
+    }
+  }
+}
+`
+
+func TestAccCloudProjectStorage_objectLock(t *testing.T) {
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	regionName := os.Getenv("OVH_CLOUD_PROJECT_STORAGE_REGION_TEST")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloudStorage(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudProjectStorageObjectLockConfig, serviceName, regionName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_storage.storage", "object_lock.0.status", "enabled"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_storage.storage", "object_lock.0.rule.0.mode", "governance"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_storage.storage", "object_lock.0.rule.0.period", "P1D"),
+				),
+			},
+		},
+	})
+}
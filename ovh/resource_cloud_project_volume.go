@@ -0,0 +1,302 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+// cloudProjectVolumePendingStatus are the statuses of a volume while an action is in progress
+var cloudProjectVolumePendingStatus = []string{"creating", "attaching", "detaching", "extending", "downloading", "deleting"}
+
+// cloudProjectVolumePendingStatusWith returns the pending statuses of a volume, plus the given ones
+// which can still be returned right after an action is requested
+func cloudProjectVolumePendingStatusWith(status ...string) []string {
+	return append(append([]string{}, cloudProjectVolumePendingStatus...), status...)
+}
+
+func resourceCloudProjectVolume() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudProjectVolumeCreate,
+		Read:   resourceCloudProjectVolumeRead,
+		Update: resourceCloudProjectVolumeUpdate,
+		Delete: resourceCloudProjectVolumeDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudProjectVolumeImportState,
+		},
+
+		CustomizeDiff: resourceCloudProjectVolumeCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+				Description: "Service name of the resource representing the id of the cloud project.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Description: "Region of the volume",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the volume",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of the volume",
+				Optional:    true,
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Description: "Size of the volume in GB, it can only be increased in place",
+				Required:    true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "Type of the volume, e.g. classic or high-speed",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"image_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the image to create a bootable volume from",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"snapshot_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the snapshot to create the volume from",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Description: "Availability zone of the volume, for the regions with several zones",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+
+			// computed
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Status of the volume",
+				Computed:    true,
+			},
+			"bootable": {
+				Type:        schema.TypeBool,
+				Description: "True if the volume is bootable",
+				Computed:    true,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: "Creation date of the volume",
+				Computed:    true,
+			},
+			"attached_to": {
+				Type:        schema.TypeList,
+				Description: "IDs of the instances the volume is attached to",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceCloudProjectVolumeImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("import Id is not service_name/volume_id formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceCloudProjectVolumeCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	region := d.Get("region").(string)
+
+	params := (&CloudProjectVolumeCreateOpts{}).FromResource(d)
+	endpoint := fmt.Sprintf("/cloud/project/%s/region/%s/volume",
+		url.PathEscape(serviceName),
+		url.PathEscape(region))
+	op := &CloudProjectOperationResponse{}
+
+	log.Printf("[DEBUG] Will create public cloud volume: %s", params)
+	if err := config.OVHClient.Post(endpoint, params, op); err != nil {
+		return fmt.Errorf("calling Post %s with params %s:\n\t %w", endpoint, params, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for operation %s creating volume %s", op.Id, params.Name)
+	op, err := waitForCloudProjectOperation(config.OVHClient, serviceName, op.Id, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	id := op.GetResourceId()
+	if id == "" {
+		return fmt.Errorf("operation %s creating volume %s has no resource id", op.Id, params.Name)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Waiting for volume %s to be available", id)
+	if err := waitForCloudProjectVolumeStatus(config.OVHClient, serviceName, id, d.Timeout(schema.TimeoutCreate), cloudProjectVolumePendingStatus, []string{"available"}); err != nil {
+		return err
+	}
+
+	return resourceCloudProjectVolumeRead(d, meta)
+}
+
+func resourceCloudProjectVolumeRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()))
+	res := &CloudProjectVolumeResponse{}
+
+	log.Printf("[DEBUG] Will read volume %s in project %s", d.Id(), serviceName)
+	if err := config.OVHClient.Get(endpoint, res); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	for k, v := range res.ToMap() {
+		if k != "id" {
+			d.Set(k, v)
+		}
+	}
+
+	log.Printf("[DEBUG] Read volume: %+v", res)
+	return nil
+}
+
+func resourceCloudProjectVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()))
+
+	if d.HasChanges("name", "description") {
+		params := &CloudProjectVolumeUpdateOpts{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}
+
+		log.Printf("[DEBUG] Will update volume %s: %+v", d.Id(), params)
+		if err := config.OVHClient.Put(endpoint, params, nil); err != nil {
+			return fmt.Errorf("calling Put %s with params %+v:\n\t %w", endpoint, params, err)
+		}
+	}
+
+	// The CustomizeDiff only lets the size increase through
+	if d.HasChange("size") {
+		params := &CloudProjectVolumeUpsizeOpts{Size: d.Get("size").(int)}
+
+		log.Printf("[DEBUG] Will resize volume %s: %+v", d.Id(), params)
+		if err := config.OVHClient.Post(endpoint+"/upsize", params, nil); err != nil {
+			return fmt.Errorf("calling Post %s/upsize with params %+v:\n\t %w", endpoint, params, err)
+		}
+
+		log.Printf("[DEBUG] Waiting for volume %s to be resized", d.Id())
+		if err := waitForCloudProjectVolumeStatus(config.OVHClient, serviceName, d.Id(), d.Timeout(schema.TimeoutUpdate), cloudProjectVolumePendingStatus, []string{"available", "in-use"}); err != nil {
+			return err
+		}
+	}
+
+	return resourceCloudProjectVolumeRead(d, meta)
+}
+
+func resourceCloudProjectVolumeDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()))
+
+	log.Printf("[DEBUG] Will delete volume %s in project %s", d.Id(), serviceName)
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Waiting for volume %s to be deleted", d.Id())
+	if err := waitForCloudProjectVolumeStatus(config.OVHClient, serviceName, d.Id(), d.Timeout(schema.TimeoutDelete), cloudProjectVolumePendingStatusWith("available"), []string{"DELETED"}); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudProjectVolumeCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// A volume can't be shrunk
+	if d.Id() != "" && d.HasChange("size") {
+		old, new := d.GetChange("size")
+		if new.(int) < old.(int) {
+			return d.ForceNew("size")
+		}
+	}
+	return nil
+}
+
+func waitForCloudProjectVolumeStatus(c *ovh.Client, serviceName, id string, timeout time.Duration, pending, targets []string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     targets,
+		Refresh:    cloudProjectVolumeStatusRefreshFunc(c, serviceName, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for volume %s to be %s: %s", id, strings.Join(targets, " or "), err)
+	}
+	return nil
+}
+
+// cloudProjectVolumeStatusRefreshFunc returns the status of a volume, or DELETED once it's gone
+func cloudProjectVolumeStatusRefreshFunc(c *ovh.Client, serviceName, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res := &CloudProjectVolumeResponse{}
+		endpoint := fmt.Sprintf("/cloud/project/%s/volume/%s",
+			url.PathEscape(serviceName),
+			url.PathEscape(id))
+		if err := c.Get(endpoint, res); err != nil {
+			if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+				return res, "DELETED", nil
+			}
+			return res, "", err
+		}
+
+		log.Printf("[DEBUG] Pending volume: %s is %s", id, res.Status)
+		return res, res.Status, nil
+	}
+}
@@ -0,0 +1,199 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+const (
+	cloudProjectVolumeAttached = "attached"
+	cloudProjectVolumeDetached = "detached"
+)
+
+func resourceCloudProjectVolumeAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudProjectVolumeAttachmentCreate,
+		Read:   resourceCloudProjectVolumeAttachmentRead,
+		Delete: resourceCloudProjectVolumeAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudProjectVolumeAttachmentImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+				Description: "Service name of the resource representing the id of the cloud project.",
+			},
+			"volume_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the volume to attach",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the instance the volume is attached to",
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceCloudProjectVolumeAttachmentImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("import Id is not service_name/volume_id/instance_id formatted")
+	}
+	d.SetId(fmt.Sprintf("%s/%s", splitId[1], splitId[2]))
+	d.Set("service_name", splitId[0])
+	d.Set("volume_id", splitId[1])
+	d.Set("instance_id", splitId[2])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceCloudProjectVolumeAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	volumeId := d.Get("volume_id").(string)
+	instanceId := d.Get("instance_id").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume/%s/attach",
+		url.PathEscape(serviceName),
+		url.PathEscape(volumeId))
+	params := &CloudProjectVolumeAttachOpts{InstanceId: instanceId}
+
+	log.Printf("[DEBUG] Will attach volume %s to instance %s", volumeId, instanceId)
+	if err := config.OVHClient.Post(endpoint, params, nil); err != nil {
+		return fmt.Errorf("calling Post %s with params %+v:\n\t %w", endpoint, params, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for volume %s to be attached to instance %s", volumeId, instanceId)
+	err := waitForCloudProjectVolumeAttachment(config.OVHClient, serviceName, volumeId, instanceId, d.Timeout(schema.TimeoutCreate), cloudProjectVolumeAttached)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", volumeId, instanceId))
+
+	return resourceCloudProjectVolumeAttachmentRead(d, meta)
+}
+
+func resourceCloudProjectVolumeAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	volumeId := d.Get("volume_id").(string)
+	instanceId := d.Get("instance_id").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(volumeId))
+	res := &CloudProjectVolumeResponse{}
+
+	log.Printf("[DEBUG] Will read volume %s in project %s", volumeId, serviceName)
+	if err := config.OVHClient.Get(endpoint, res); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	if !res.IsAttachedTo(instanceId) {
+		log.Printf("[WARN] volume %s is not attached to instance %s anymore, removing it from state", volumeId, instanceId)
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceCloudProjectVolumeAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	volumeId := d.Get("volume_id").(string)
+	instanceId := d.Get("instance_id").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume/%s/detach",
+		url.PathEscape(serviceName),
+		url.PathEscape(volumeId))
+	params := &CloudProjectVolumeAttachOpts{InstanceId: instanceId}
+
+	log.Printf("[DEBUG] Will detach volume %s from instance %s", volumeId, instanceId)
+	if err := config.OVHClient.Post(endpoint, params, nil); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Waiting for volume %s to be detached from instance %s", volumeId, instanceId)
+	err := waitForCloudProjectVolumeAttachment(config.OVHClient, serviceName, volumeId, instanceId, d.Timeout(schema.TimeoutDelete), cloudProjectVolumeDetached)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// waitForCloudProjectVolumeAttachment waits for the volume to be attached to or detached from the instance
+func waitForCloudProjectVolumeAttachment(c *ovh.Client, serviceName, volumeId, instanceId string, timeout time.Duration, target string) error {
+	pending := cloudProjectVolumeAttached
+	if target == cloudProjectVolumeAttached {
+		pending = cloudProjectVolumeDetached
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    cloudProjectVolumePendingStatusWith(pending),
+		Target:     []string{target},
+		Refresh:    cloudProjectVolumeAttachmentRefreshFunc(c, serviceName, volumeId, instanceId),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for volume %s to be %s: %s", volumeId, target, err)
+	}
+	return nil
+}
+
+// cloudProjectVolumeAttachmentRefreshFunc returns the status of the volume while an action is in progress,
+// then whether it is attached to the instance
+func cloudProjectVolumeAttachmentRefreshFunc(c *ovh.Client, serviceName, volumeId, instanceId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res := &CloudProjectVolumeResponse{}
+		endpoint := fmt.Sprintf("/cloud/project/%s/volume/%s",
+			url.PathEscape(serviceName),
+			url.PathEscape(volumeId))
+		if err := c.Get(endpoint, res); err != nil {
+			if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+				return res, cloudProjectVolumeDetached, nil
+			}
+			return res, "", err
+		}
+
+		log.Printf("[DEBUG] Pending volume: %s is %s, attached to %v", volumeId, res.Status, res.AttachedTo)
+		if slices.Contains(cloudProjectVolumePendingStatus, res.Status) {
+			return res, res.Status, nil
+		}
+		if res.IsAttachedTo(instanceId) {
+			return res, cloudProjectVolumeAttached, nil
+		}
+		return res, cloudProjectVolumeDetached, nil
+	}
+}
@@ -0,0 +1,75 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testAccCloudProjectVolumeAttachmentConfig = `
+resource "ovh_cloud_project_instance" "instance" {
+  service_name = "%s"
+  region       = "%s"
+  name         = "%s"
+  flavor_id    = "%s"
+  image_id     = "%s"
+
+  network {
+    public = true
+  }
+}
+
+resource "ovh_cloud_project_volume" "volume" {
+  service_name = ovh_cloud_project_instance.instance.service_name
+  region       = ovh_cloud_project_instance.instance.region
+  name         = "%s"
+  size         = 10
+}
+
+resource "ovh_cloud_project_volume_attachment" "attachment" {
+  service_name = ovh_cloud_project_volume.volume.service_name
+  volume_id    = ovh_cloud_project_volume.volume.id
+  instance_id  = ovh_cloud_project_instance.instance.id
+}
+
+data "ovh_cloud_project_volumes" "volumes" {
+  service_name = ovh_cloud_project_volume_attachment.attachment.service_name
+  region       = ovh_cloud_project_volume.volume.region
+}
+`
+
+func TestAccCloudProjectVolumeAttachment_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	region := os.Getenv("OVH_CLOUD_PROJECT_REGION_TEST")
+	flavorId := os.Getenv("OVH_CLOUD_PROJECT_INSTANCE_FLAVOR_ID_TEST")
+	imageId := os.Getenv("OVH_CLOUD_PROJECT_INSTANCE_IMAGE_ID_TEST")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloudInstance(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudProjectVolumeAttachmentConfig, serviceName, region, name, flavorId, imageId, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ovh_cloud_project_volume_attachment.attachment", "volume_id",
+						"ovh_cloud_project_volume.volume", "id",
+					),
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_project_volumes.volumes", "volumes.#"),
+				),
+			},
+			{
+				ResourceName:        "ovh_cloud_project_volume_attachment.attachment",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: serviceName + "/",
+			},
+		},
+	})
+}
@@ -0,0 +1,203 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceCloudProjectVolumeSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudProjectVolumeSnapshotCreate,
+		Read:   resourceCloudProjectVolumeSnapshotRead,
+		Delete: resourceCloudProjectVolumeSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudProjectVolumeSnapshotImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+				Description: "Service name of the resource representing the id of the cloud project.",
+			},
+			"volume_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the volume to snapshot",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the snapshot",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of the snapshot",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+
+			// computed
+			"size": {
+				Type:        schema.TypeInt,
+				Description: "Size of the snapshot in GB",
+				Computed:    true,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Description: "Region of the snapshot",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Status of the snapshot",
+				Computed:    true,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: "Creation date of the snapshot",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceCloudProjectVolumeSnapshotImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("import Id is not service_name/snapshot_id formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceCloudProjectVolumeSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	volumeId := d.Get("volume_id").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume/%s/snapshot",
+		url.PathEscape(serviceName),
+		url.PathEscape(volumeId))
+	params := &CloudProjectVolumeSnapshotCreateOpts{
+		Name:        helpers.GetNilStringPointerFromData(d, "name"),
+		Description: helpers.GetNilStringPointerFromData(d, "description"),
+	}
+	res := &CloudProjectVolumeSnapshotResponse{}
+
+	log.Printf("[DEBUG] Will create snapshot of volume %s: %+v", volumeId, params)
+	if err := config.OVHClient.Post(endpoint, params, res); err != nil {
+		return fmt.Errorf("calling Post %s with params %+v:\n\t %w", endpoint, params, err)
+	}
+	d.SetId(res.Id)
+
+	log.Printf("[DEBUG] Waiting for snapshot %s to be available", res.Id)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    cloudProjectVolumeSnapshotStatusRefreshFunc(config.OVHClient, serviceName, res.Id),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for snapshot %s to be available: %s", res.Id, err)
+	}
+
+	return resourceCloudProjectVolumeSnapshotRead(d, meta)
+}
+
+func resourceCloudProjectVolumeSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume/snapshot/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()))
+	res := &CloudProjectVolumeSnapshotResponse{}
+
+	log.Printf("[DEBUG] Will read snapshot %s in project %s", d.Id(), serviceName)
+	if err := config.OVHClient.Get(endpoint, res); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	for k, v := range res.ToMap() {
+		d.Set(k, v)
+	}
+
+	log.Printf("[DEBUG] Read snapshot: %+v", res)
+	return nil
+}
+
+func resourceCloudProjectVolumeSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume/snapshot/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()))
+
+	log.Printf("[DEBUG] Will delete snapshot %s in project %s", d.Id(), serviceName)
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Waiting for snapshot %s to be deleted", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "deleting"},
+		Target:     []string{"DELETED"},
+		Refresh:    cloudProjectVolumeSnapshotStatusRefreshFunc(config.OVHClient, serviceName, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for snapshot %s to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// cloudProjectVolumeSnapshotStatusRefreshFunc returns the status of a snapshot, or DELETED once it's gone
+func cloudProjectVolumeSnapshotStatusRefreshFunc(c *ovh.Client, serviceName, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res := &CloudProjectVolumeSnapshotResponse{}
+		endpoint := fmt.Sprintf("/cloud/project/%s/volume/snapshot/%s",
+			url.PathEscape(serviceName),
+			url.PathEscape(id))
+		if err := c.Get(endpoint, res); err != nil {
+			if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+				return res, "DELETED", nil
+			}
+			return res, "", err
+		}
+
+		log.Printf("[DEBUG] Pending snapshot: %s is %s", id, res.Status)
+		return res, res.Status, nil
+	}
+}
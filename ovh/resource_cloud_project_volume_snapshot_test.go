@@ -0,0 +1,97 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("ovh_cloud_project_volume_snapshot", &resource.Sweeper{
+		Name: "ovh_cloud_project_volume_snapshot",
+		F:    testSweepCloudProjectVolumeSnapshot,
+	})
+}
+
+func testSweepCloudProjectVolumeSnapshot(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	if serviceName == "" {
+		log.Print("[DEBUG] OVH_CLOUD_PROJECT_SERVICE_TEST is not set. No volume snapshot to sweep")
+		return nil
+	}
+
+	snapshots := make([]CloudProjectVolumeSnapshotResponse, 0)
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume/snapshot", serviceName)
+	if err := client.Get(endpoint, &snapshots); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	for _, snapshot := range snapshots {
+		if !strings.HasPrefix(snapshot.Name, test_prefix) {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting volume snapshot %s/%s", snapshot.Name, snapshot.Id)
+		if err := client.Delete(fmt.Sprintf("%s/%s", endpoint, snapshot.Id), nil); err != nil {
+			return fmt.Errorf("Error deleting volume snapshot %s:\n\t %q", snapshot.Id, err)
+		}
+	}
+	return nil
+}
+
+var testAccCloudProjectVolumeSnapshotConfig = `
+resource "ovh_cloud_project_volume" "volume" {
+  service_name = "%s"
+  region       = "%s"
+  name         = "%s"
+  size         = 10
+}
+
+resource "ovh_cloud_project_volume_snapshot" "snapshot" {
+  service_name = ovh_cloud_project_volume.volume.service_name
+  volume_id    = ovh_cloud_project_volume.volume.id
+  name         = "%s"
+}
+
+resource "ovh_cloud_project_volume" "restored" {
+  service_name = ovh_cloud_project_volume.volume.service_name
+  region       = ovh_cloud_project_volume.volume.region
+  name         = "%s-restored"
+  size         = 10
+  snapshot_id  = ovh_cloud_project_volume_snapshot.snapshot.id
+}
+`
+
+func TestAccCloudProjectVolumeSnapshot_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	region := os.Getenv("OVH_CLOUD_PROJECT_REGION_TEST")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloudRegion(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudProjectVolumeSnapshotConfig, serviceName, region, name, name, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_volume_snapshot.snapshot", "name", name),
+					resource.TestCheckResourceAttr("ovh_cloud_project_volume_snapshot.snapshot", "status", "available"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_volume_snapshot.snapshot", "size", "10"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_volume.restored", "status", "available"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,115 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("ovh_cloud_project_volume", &resource.Sweeper{
+		Name: "ovh_cloud_project_volume",
+		Dependencies: []string{
+			"ovh_cloud_project_instance",
+			"ovh_cloud_project_volume_snapshot",
+		},
+		F: testSweepCloudProjectVolume,
+	})
+}
+
+func testSweepCloudProjectVolume(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	if serviceName == "" {
+		log.Print("[DEBUG] OVH_CLOUD_PROJECT_SERVICE_TEST is not set. No volume to sweep")
+		return nil
+	}
+
+	volumes := make([]CloudProjectVolumeResponse, 0)
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume", serviceName)
+	if err := client.Get(endpoint, &volumes); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	for _, volume := range volumes {
+		if !strings.HasPrefix(volume.Name, test_prefix) {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting volume %s/%s", volume.Name, volume.Id)
+		if err := client.Delete(fmt.Sprintf("%s/%s", endpoint, volume.Id), nil); err != nil {
+			return fmt.Errorf("Error deleting volume %s:\n\t %q", volume.Id, err)
+		}
+	}
+	return nil
+}
+
+func TestCloudProjectVolumeResponse_IsAttachedTo(t *testing.T) {
+	volume := CloudProjectVolumeResponse{AttachedTo: []string{"instance-1", "instance-2"}}
+
+	if !volume.IsAttachedTo("instance-2") {
+		t.Errorf("volume should be attached to instance-2")
+	}
+	if volume.IsAttachedTo("instance-3") {
+		t.Errorf("volume should not be attached to instance-3")
+	}
+}
+
+var testAccCloudProjectVolumeConfig = `
+resource "ovh_cloud_project_volume" "volume" {
+  service_name = "%s"
+  region       = "%s"
+  name         = "%s"
+  description  = "terraform acceptance test"
+  size         = %d
+  type         = "classic"
+}
+`
+
+func TestAccCloudProjectVolume_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	region := os.Getenv("OVH_CLOUD_PROJECT_REGION_TEST")
+	name := acctest.RandomWithPrefix(test_prefix)
+	updatedName := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloudRegion(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudProjectVolumeConfig, serviceName, region, name, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_volume.volume", "name", name),
+					resource.TestCheckResourceAttr("ovh_cloud_project_volume.volume", "size", "10"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_volume.volume", "type", "classic"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_volume.volume", "status", "available"),
+				),
+			},
+			{
+				// the volume is renamed and upsized in place
+				Config: fmt.Sprintf(testAccCloudProjectVolumeConfig, serviceName, region, updatedName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_volume.volume", "name", updatedName),
+					resource.TestCheckResourceAttr("ovh_cloud_project_volume.volume", "size", "20"),
+				),
+			},
+			{
+				ResourceName:        "ovh_cloud_project_volume.volume",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: serviceName + "/",
+			},
+		},
+	})
+}
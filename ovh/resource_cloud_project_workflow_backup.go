@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
 	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
 )
 
@@ -18,6 +19,7 @@ func resourceCloudProjectWorkflowBackup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCloudProjectWorkflowBackupCreate,
 		Read:   resourceCloudProjectWorkflowBackupRead,
+		Update: resourceCloudProjectWorkflowBackupUpdate,
 		Delete: resourceCloudProjectWorkflowBackupDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -30,7 +32,8 @@ func resourceCloudProjectWorkflowBackup() *schema.Resource {
 	}
 }
 
-// No endpoint to update the workflow hence all attributes are ForceNew : true
+// There is no endpoint to update a workflow: the schedule attributes are updated
+// by replacing the workflow behind the scenes, the other ones are ForceNew
 func resourceCloudProjectWorkflowBackupSchema() map[string]*schema.Schema {
 	schema := map[string]*schema.Schema{
 		OVH_CLOUD_PROJECT_WORKFLOW_BACKUP_SERVICE: {
@@ -48,7 +51,6 @@ func resourceCloudProjectWorkflowBackupSchema() map[string]*schema.Schema {
 		"cron": {
 			Type:     schema.TypeString,
 			Required: true,
-			ForceNew: true,
 		},
 		"instance_id": {
 			Type:     schema.TypeString,
@@ -59,7 +61,6 @@ func resourceCloudProjectWorkflowBackupSchema() map[string]*schema.Schema {
 			Type:     schema.TypeInt,
 			Optional: true,
 			Computed: false,
-			ForceNew: true,
 		},
 		"name": {
 			Type:     schema.TypeString,
@@ -70,7 +71,6 @@ func resourceCloudProjectWorkflowBackupSchema() map[string]*schema.Schema {
 		"rotation": {
 			Type:     schema.TypeInt,
 			Required: true,
-			ForceNew: true,
 		},
 
 		"backup_name": {
@@ -134,6 +134,65 @@ func resourceCloudProjectWorkflowBackupRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
+// resourceCloudProjectWorkflowBackupUpdate deletes the previous workflow then creates one
+// with the new schedule, the snapshots already taken are kept
+func resourceCloudProjectWorkflowBackupUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get(OVH_CLOUD_PROJECT_WORKFLOW_BACKUP_SERVICE).(string)
+	regionName := d.Get(OVH_CLOUD_PROJECT_WORKFLOW_BACKUP_REGION).(string)
+	endpoint := fmt.Sprintf(OVH_CLOUD_PROJECT_WORKFLOW_BACKUP_ENDPOINT, serviceName, regionName)
+	params := (&CloudProjectWorkflowBackupCreateOpts{}).FromResource(d)
+	res := &CloudProjectWorkflowBackupResponse{}
+
+	// Keep the previous workflow in the state until the new one is created
+	d.Partial(true)
+
+	// Two workflows must not back up the same instance: the previous one is deleted first
+	oldEndpoint := endpoint + "/" + d.Id()
+	log.Printf("[DEBUG] will delete workflow %s to replace it", d.Id())
+	if err := config.OVHClient.Delete(oldEndpoint, nil); err != nil {
+		if errOvh, ok := err.(*ovh.APIError); !ok || errOvh.Code != 404 {
+			return fmt.Errorf("calling Delete %s:\n\t %w", oldEndpoint, err)
+		}
+	}
+
+	// backup_name is known from the previous workflow, the new snapshots are named like the existing ones
+	log.Printf("[DEBUG] will replace workflow %s on %s", d.Id(), endpoint)
+	if err := config.OVHClient.Post(endpoint, params, res); err != nil {
+		oldParams := cloudProjectWorkflowBackupPreviousOpts(d)
+		log.Printf("[DEBUG] will recreate workflow %s with params %+v", d.Id(), oldParams)
+		if errRollback := config.OVHClient.Post(endpoint, oldParams, res); errRollback != nil {
+			// the previous workflow is gone
+			d.SetId("")
+			return fmt.Errorf("calling Post %s with param %+v:\n\t %w\n\t the deleted workflow couldn't be recreated either, the instance isn't backed up anymore: calling Post %s with param %+v:\n\t %s",
+				endpoint, params, err, endpoint, oldParams, errRollback)
+		}
+		d.SetId(res.Id)
+		return fmt.Errorf("calling Post %s with param %+v, the previous workflow has been recreated as %s:\n\t %w", endpoint, params, res.Id, err)
+	}
+
+	d.SetId(res.Id)
+	d.Partial(false)
+
+	return resourceCloudProjectWorkflowBackupRead(d, meta)
+}
+
+// cloudProjectWorkflowBackupPreviousOpts returns the params of the workflow being replaced,
+// to recreate it when the new one can't be created
+func cloudProjectWorkflowBackupPreviousOpts(d *schema.ResourceData) *CloudProjectWorkflowBackupCreateOpts {
+	opts := (&CloudProjectWorkflowBackupCreateOpts{}).FromResource(d)
+
+	oldCron, _ := d.GetChange("cron")
+	opts.Cron = helpers.GetNilStringPointer(oldCron)
+	oldRotation, _ := d.GetChange("rotation")
+	opts.Rotation = helpers.GetNilInt64Pointer(oldRotation)
+	opts.MaxExecutionCount = nil
+	if oldMaxExecutionCount, _ := d.GetChange("max_execution_count"); oldMaxExecutionCount.(int) != 0 {
+		opts.MaxExecutionCount = helpers.GetNilInt64Pointer(oldMaxExecutionCount)
+	}
+	return opts
+}
+
 func resourceCloudProjectWorkflowBackupDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	serviceName := d.Get(OVH_CLOUD_PROJECT_WORKFLOW_BACKUP_SERVICE).(string)
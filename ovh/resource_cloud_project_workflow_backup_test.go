@@ -8,6 +8,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 const WORKFLOW_BACKUP_TEST_CONF = `
@@ -25,7 +27,7 @@ const WORKFLOW_BACKUP_TEST_CONF_UPDATED = `
 resource "ovh_cloud_project_workflow_backup" "my_backup"{
 	service_name		= "%s"
 	region_name				= "%s"
-	cron				= "50 4 * * *"
+	cron				= "50 5 * * *"
 	instance_id			= "%s"
 	max_execution_count	= "0"
 	name				= "%s"
@@ -110,12 +112,45 @@ func TestAccCloudProjectWorkflowBackup(t *testing.T) {
 					instanceId,
 					name,
 				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(WORKFLOW_BACKUP_RESOURCE_NAME, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(WORKFLOW_BACKUP_RESOURCE_NAME, OVH_CLOUD_PROJECT_WORKFLOW_BACKUP_SERVICE, serviceName),
 					resource.TestCheckResourceAttr(WORKFLOW_BACKUP_RESOURCE_NAME, "name", name),
 					resource.TestCheckResourceAttr(WORKFLOW_BACKUP_RESOURCE_NAME, "instance_id", instanceId),
+					resource.TestCheckResourceAttr(WORKFLOW_BACKUP_RESOURCE_NAME, "cron", "50 5 * * *"),
+					resource.TestCheckResourceAttr(WORKFLOW_BACKUP_RESOURCE_NAME, "rotation", "5"),
+					resource.TestCheckResourceAttr(WORKFLOW_BACKUP_RESOURCE_NAME, "backup_name", name),
+					testAccCheckCloudProjectWorkflowBackupSingle(serviceName, os.Getenv(WORKFLOW_BACKUP_TEST_REGION_ENV_VAR), instanceId),
 				),
 			},
 		},
 	})
 }
+
+// testAccCheckCloudProjectWorkflowBackupSingle checks the instance is backed up by the workflow of the resource only,
+// the previous workflow being deleted when it is replaced
+func testAccCheckCloudProjectWorkflowBackupSingle(serviceName, region, instanceId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[WORKFLOW_BACKUP_RESOURCE_NAME]
+		if !ok {
+			return fmt.Errorf("resource %s not found", WORKFLOW_BACKUP_RESOURCE_NAME)
+		}
+
+		var workflows []CloudProjectWorkflowBackupResponse
+		endpoint := fmt.Sprintf(OVH_CLOUD_PROJECT_WORKFLOW_BACKUP_ENDPOINT, serviceName, region)
+		if err := testAccOVHClient.Get(endpoint, &workflows); err != nil {
+			return fmt.Errorf("calling Get %s:\n\t %w", endpoint, err)
+		}
+
+		for _, wf := range workflows {
+			if wf.InstanceId == instanceId && wf.Id != rs.Primary.ID {
+				return fmt.Errorf("workflow %s backs up instance %s next to workflow %s", wf.Id, instanceId, rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
@@ -2,6 +2,7 @@ package ovh
 
 import (
 	"fmt"
+	"net"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
@@ -49,6 +50,90 @@ type CloudProjectGatewayResponse struct {
 	Model               string                          `json:"model"`
 }
 
+// SnatIp returns the public IPv4 used by the gateway for the outgoing traffic,
+// to be whitelisted by the remote services
+func (v CloudProjectGatewayResponse) SnatIp() string {
+	if v.ExternalInformation == nil {
+		return ""
+	}
+	for _, externalIp := range v.ExternalInformation.Ips {
+		if externalIp == nil {
+			continue
+		}
+		if ip := net.ParseIP(externalIp.Ip); ip != nil && ip.To4() != nil {
+			return externalIp.Ip
+		}
+	}
+	return ""
+}
+
+func (v CloudProjectGatewayInterface) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["ip"] = v.Ip
+	obj["subnet_id"] = v.SubnetId
+	obj["network_id"] = v.NetworkId
+	return obj
+}
+
+type CloudProjectGatewayInterfaceCreateOpts struct {
+	SubnetId string `json:"subnetId"`
+}
+
+func (p *CloudProjectGatewayInterfaceCreateOpts) String() string {
+	return fmt.Sprintf("subnetId: %s", p.SubnetId)
+}
+
+type CloudProjectFloatingIpAssociatedEntity struct {
+	Id        string `json:"id"`
+	Ip        string `json:"ip"`
+	GatewayId string `json:"gatewayId"`
+	Type      string `json:"type"`
+}
+
+type CloudProjectFloatingIpResponse struct {
+	Id               string                                  `json:"id"`
+	Ip               string                                  `json:"ip"`
+	NetworkId        string                                  `json:"networkId"`
+	Region           string                                  `json:"region"`
+	Status           string                                  `json:"status"`
+	AssociatedEntity *CloudProjectFloatingIpAssociatedEntity `json:"associatedEntity"`
+}
+
+func (v CloudProjectFloatingIpResponse) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["ip"] = v.Ip
+	obj["network_id"] = v.NetworkId
+	obj["region_name"] = v.Region
+	obj["status"] = v.Status
+
+	associatedEntity := make([]map[string]interface{}, 0, 1)
+	if v.AssociatedEntity != nil {
+		associatedEntity = append(associatedEntity, map[string]interface{}{
+			"id":         v.AssociatedEntity.Id,
+			"ip":         v.AssociatedEntity.Ip,
+			"gateway_id": v.AssociatedEntity.GatewayId,
+			"type":       v.AssociatedEntity.Type,
+		})
+	}
+	obj["associated_entity"] = associatedEntity
+
+	return obj
+}
+
+type CloudProjectFloatingIpCreateOpts struct {
+	NetworkId *string `json:"networkId,omitempty"`
+}
+
+func (opts *CloudProjectFloatingIpCreateOpts) FromResource(d *schema.ResourceData) *CloudProjectFloatingIpCreateOpts {
+	opts.NetworkId = helpers.GetNilStringPointerFromData(d, "network_id")
+	return opts
+}
+
+type CloudProjectFloatingIpAssociateOpts struct {
+	FloatingIpId string `json:"floatingIpId"`
+	Ip           string `json:"ip,omitempty"`
+}
+
 type CloudProjectOperationResponse struct {
 	Id          string   `json:"id"`
 	Action      string   `json:"action"`
@@ -59,6 +144,25 @@ type CloudProjectOperationResponse struct {
 	Regions     []string `json:"regions"`
 	ResourceId  *string  `json:"resourceId"`
 	Status      string   `json:"status"`
+
+	SubOperations []*CloudProjectOperationResponse `json:"subOperations"`
+}
+
+// GetResourceId returns the id of the resource handled by the operation,
+// looking into the sub operations when the operation itself has none
+func (o *CloudProjectOperationResponse) GetResourceId() string {
+	if o.ResourceId != nil && *o.ResourceId != "" {
+		return *o.ResourceId
+	}
+	for _, sub := range o.SubOperations {
+		if sub == nil {
+			continue
+		}
+		if id := sub.GetResourceId(); id != "" {
+			return id
+		}
+	}
+	return ""
 }
 
 // Opts
@@ -231,6 +335,7 @@ func (u CloudProjectUserS3CredentialSecret) ToMap() map[string]interface{} {
 }
 
 type CloudProjectRegionResponse struct {
+	AvailabilityZones  []string                     `json:"availabilityZones"`
 	ContinentCode      string                       `json:"continentCode"`
 	DatacenterLocation string                       `json:"datacenterLocation"`
 	Name               string                       `json:"name"`
@@ -0,0 +1,347 @@
+package ovh
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	harborSelectorKind      = "doublestar"
+	harborTagMatches        = "matches"
+	harborTagExcludes       = "excludes"
+	harborRepositoryMatches = "repoMatches"
+	harborRepositoryExclude = "repoExcludes"
+)
+
+// Projects
+
+type HarborProjectMetadata struct {
+	Public      string  `json:"public"`
+	RetentionId *string `json:"retention_id,omitempty"`
+}
+
+type HarborProjectCreateOpts struct {
+	ProjectName  string                `json:"project_name"`
+	Metadata     HarborProjectMetadata `json:"metadata"`
+	StorageLimit int64                 `json:"storage_limit"`
+}
+
+func (opts *HarborProjectCreateOpts) FromResource(d *schema.ResourceData) *HarborProjectCreateOpts {
+	opts.ProjectName = d.Get("name").(string)
+	opts.Metadata.Public = strconv.FormatBool(d.Get("public").(bool))
+	opts.StorageLimit = int64(d.Get("storage_limit").(int))
+	return opts
+}
+
+type HarborProjectUpdateOpts struct {
+	Metadata HarborProjectMetadata `json:"metadata"`
+}
+
+type HarborProject struct {
+	ProjectId int64                 `json:"project_id"`
+	Name      string                `json:"name"`
+	RepoCount int                   `json:"repo_count"`
+	Metadata  HarborProjectMetadata `json:"metadata"`
+}
+
+func (v HarborProject) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["name"] = v.Name
+	obj["public"] = v.Metadata.Public == "true"
+	obj["repo_count"] = v.RepoCount
+	return obj
+}
+
+// RetentionId returns the id of the retention policy of the project, 0 when it has none
+func (v HarborProject) RetentionId() int64 {
+	if v.Metadata.RetentionId == nil {
+		return 0
+	}
+	id, _ := strconv.ParseInt(*v.Metadata.RetentionId, 10, 64)
+	return id
+}
+
+type HarborQuota struct {
+	Id   int64            `json:"id"`
+	Hard map[string]int64 `json:"hard"`
+	Used map[string]int64 `json:"used"`
+}
+
+type HarborQuotaUpdateOpts struct {
+	Hard map[string]int64 `json:"hard"`
+}
+
+// Robot accounts
+
+type HarborRobotAccess struct {
+	Resource string `json:"resource"`
+	Action   string `json:"action"`
+}
+
+type HarborRobotPermission struct {
+	Kind      string              `json:"kind"`
+	Namespace string              `json:"namespace"`
+	Access    []HarborRobotAccess `json:"access"`
+}
+
+type HarborRobot struct {
+	Id          int64                   `json:"id,omitempty"`
+	Name        string                  `json:"name"`
+	Description string                  `json:"description"`
+	Level       string                  `json:"level"`
+	Duration    int64                   `json:"duration"`
+	Disable     bool                    `json:"disable"`
+	ExpiresAt   int64                   `json:"expires_at,omitempty"`
+	Permissions []HarborRobotPermission `json:"permissions"`
+}
+
+func (opts *HarborRobot) FromResource(d *schema.ResourceData) *HarborRobot {
+	opts.Name = d.Get("name").(string)
+	opts.Description = d.Get("description").(string)
+	opts.Level = d.Get("level").(string)
+	opts.Duration = int64(d.Get("duration").(int))
+	opts.Disable = d.Get("disabled").(bool)
+
+	opts.Permissions = make([]HarborRobotPermission, 0)
+	for _, p := range d.Get("permission").([]interface{}) {
+		permission := p.(map[string]interface{})
+		access := make([]HarborRobotAccess, 0)
+		for _, a := range permission["access"].(*schema.Set).List() {
+			access = append(access, HarborRobotAccess{
+				Resource: a.(map[string]interface{})["resource"].(string),
+				Action:   a.(map[string]interface{})["action"].(string),
+			})
+		}
+		opts.Permissions = append(opts.Permissions, HarborRobotPermission{
+			Kind:      "project",
+			Namespace: permission["namespace"].(string),
+			Access:    access,
+		})
+	}
+	return opts
+}
+
+func (v HarborRobot) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["robot_name"] = v.Name
+	obj["description"] = v.Description
+	obj["level"] = v.Level
+	obj["duration"] = v.Duration
+	obj["disabled"] = v.Disable
+	obj["expires_at"] = v.ExpiresAt
+
+	permissions := make([]map[string]interface{}, 0, len(v.Permissions))
+	for _, p := range v.Permissions {
+		access := make([]interface{}, 0, len(p.Access))
+		for _, a := range p.Access {
+			access = append(access, map[string]interface{}{
+				"resource": a.Resource,
+				"action":   a.Action,
+			})
+		}
+		permissions = append(permissions, map[string]interface{}{
+			"namespace": p.Namespace,
+			"access":    access,
+		})
+	}
+	obj["permission"] = permissions
+	return obj
+}
+
+type HarborRobotCreateResponse struct {
+	Id     int64  `json:"id"`
+	Name   string `json:"name"`
+	Secret string `json:"secret"`
+}
+
+// harborRobotShortName returns the name of a robot account as given at its creation,
+// Harbor prefixes it with robot$ and the project name for the project robots
+func harborRobotShortName(fullName string) string {
+	name := strings.TrimPrefix(fullName, "robot$")
+	if i := strings.LastIndex(name, "+"); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
+
+// validateHarborRobotPermissions checks the permissions against the level of the robot:
+// a project robot has access to a single project
+func validateHarborRobotPermissions(level string, namespaces []string) error {
+	if level != "project" {
+		return nil
+	}
+	if len(namespaces) != 1 {
+		return fmt.Errorf("a project robot must have exactly one permission block, got %d", len(namespaces))
+	}
+	if namespaces[0] == "*" {
+		return fmt.Errorf("a project robot can't have access to all the projects, use a system robot")
+	}
+	return nil
+}
+
+// Tag rules, shared by the retention policies and the immutability rules
+
+type HarborSelector struct {
+	Kind       string `json:"kind"`
+	Decoration string `json:"decoration"`
+	Pattern    string `json:"pattern"`
+	Extras     string `json:"extras,omitempty"`
+}
+
+type HarborTagRule struct {
+	Id             int64                       `json:"id,omitempty"`
+	Priority       int                         `json:"priority,omitempty"`
+	Disabled       bool                        `json:"disabled"`
+	Action         string                      `json:"action"`
+	Template       string                      `json:"template"`
+	Params         map[string]interface{}      `json:"params,omitempty"`
+	TagSelectors   []HarborSelector            `json:"tag_selectors"`
+	ScopeSelectors map[string][]HarborSelector `json:"scope_selectors"`
+}
+
+type harborSelectorExtras struct {
+	Untagged bool `json:"untagged"`
+}
+
+// selectorsFromMap sets the repository and tag selectors of a rule
+// from the attributes of its terraform block
+func (r *HarborTagRule) selectorsFromMap(m map[string]interface{}) {
+	repositoryDecoration := harborRepositoryMatches
+	if m["repository_exclude"].(bool) {
+		repositoryDecoration = harborRepositoryExclude
+	}
+	r.ScopeSelectors = map[string][]HarborSelector{
+		"repository": {{
+			Kind:       harborSelectorKind,
+			Decoration: repositoryDecoration,
+			Pattern:    m["repository_pattern"].(string),
+		}},
+	}
+
+	tagDecoration := harborTagMatches
+	if m["tag_exclude"].(bool) {
+		tagDecoration = harborTagExcludes
+	}
+	tagSelector := HarborSelector{
+		Kind:       harborSelectorKind,
+		Decoration: tagDecoration,
+		Pattern:    m["tag_pattern"].(string),
+	}
+	if untagged, ok := m["untagged_artifacts"]; ok {
+		extras, _ := json.Marshal(harborSelectorExtras{Untagged: untagged.(bool)})
+		tagSelector.Extras = string(extras)
+	}
+	r.TagSelectors = []HarborSelector{tagSelector}
+}
+
+// selectorsToMap returns the attributes of the terraform block of a rule
+// from its repository and tag selectors
+func (r HarborTagRule) selectorsToMap(withUntagged bool) map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["disabled"] = r.Disabled
+
+	if repositories := r.ScopeSelectors["repository"]; len(repositories) > 0 {
+		obj["repository_pattern"] = repositories[0].Pattern
+		obj["repository_exclude"] = repositories[0].Decoration == harborRepositoryExclude
+	}
+
+	if len(r.TagSelectors) > 0 {
+		obj["tag_pattern"] = r.TagSelectors[0].Pattern
+		obj["tag_exclude"] = r.TagSelectors[0].Decoration == harborTagExcludes
+		if withUntagged {
+			extras := harborSelectorExtras{}
+			if r.TagSelectors[0].Extras != "" {
+				json.Unmarshal([]byte(r.TagSelectors[0].Extras), &extras)
+			}
+			obj["untagged_artifacts"] = extras.Untagged
+		}
+	}
+	return obj
+}
+
+// Retention policies
+
+type HarborRetentionTrigger struct {
+	Kind     string            `json:"kind"`
+	Settings map[string]string `json:"settings"`
+}
+
+type HarborRetentionScope struct {
+	Level string `json:"level"`
+	Ref   int64  `json:"ref"`
+}
+
+type HarborRetentionPolicy struct {
+	Id        int64                  `json:"id,omitempty"`
+	Algorithm string                 `json:"algorithm"`
+	Rules     []HarborTagRule        `json:"rules"`
+	Trigger   HarborRetentionTrigger `json:"trigger"`
+	Scope     HarborRetentionScope   `json:"scope"`
+}
+
+func (opts *HarborRetentionPolicy) FromResource(d *schema.ResourceData) *HarborRetentionPolicy {
+	opts.Algorithm = "or"
+	opts.Scope = HarborRetentionScope{Level: "project", Ref: int64(d.Get("project_id").(int))}
+	opts.Trigger = HarborRetentionTrigger{
+		Kind:     "Schedule",
+		Settings: map[string]string{"cron": d.Get("schedule").(string)},
+	}
+
+	opts.Rules = make([]HarborTagRule, 0)
+	for _, r := range d.Get("rule").([]interface{}) {
+		m := r.(map[string]interface{})
+		rule := HarborTagRule{
+			Disabled: m["disabled"].(bool),
+			Action:   "retain",
+			Template: m["template"].(string),
+			Params:   map[string]interface{}{},
+		}
+		if rule.Template != "always" {
+			rule.Params[rule.Template] = m["value"].(int)
+		}
+		rule.selectorsFromMap(m)
+		opts.Rules = append(opts.Rules, rule)
+	}
+	return opts
+}
+
+func (v HarborRetentionPolicy) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["project_id"] = v.Scope.Ref
+	obj["schedule"] = v.Trigger.Settings["cron"]
+
+	rules := make([]map[string]interface{}, 0, len(v.Rules))
+	for _, r := range v.Rules {
+		rule := r.selectorsToMap(true)
+		rule["template"] = r.Template
+		rule["value"] = 0
+		// numbers are decoded as float64
+		if value, ok := r.Params[r.Template].(float64); ok {
+			rule["value"] = int(value)
+		}
+		rules = append(rules, rule)
+	}
+	obj["rule"] = rules
+	return obj
+}
+
+// Immutability rules
+
+func harborImmutabilityRuleFromResource(d *schema.ResourceData) *HarborTagRule {
+	rule := &HarborTagRule{
+		Disabled: d.Get("disabled").(bool),
+		Action:   "immutable",
+		Template: "immutable_template",
+	}
+	rule.selectorsFromMap(map[string]interface{}{
+		"repository_pattern": d.Get("repository_pattern"),
+		"repository_exclude": d.Get("repository_exclude"),
+		"tag_pattern":        d.Get("tag_pattern"),
+		"tag_exclude":        d.Get("tag_exclude"),
+	})
+	return rule
+}
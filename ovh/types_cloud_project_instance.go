@@ -0,0 +1,204 @@
+package ovh
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+type CloudProjectInstanceIpAddress struct {
+	Ip        string `json:"ip"`
+	Type      string `json:"type"`
+	Version   int    `json:"version"`
+	NetworkId string `json:"networkId"`
+	GatewayIp string `json:"gatewayIp"`
+}
+
+func (v CloudProjectInstanceIpAddress) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["ip"] = v.Ip
+	obj["type"] = v.Type
+	obj["version"] = v.Version
+	obj["network_id"] = v.NetworkId
+	obj["gateway_ip"] = v.GatewayIp
+	return obj
+}
+
+type CloudProjectInstanceFlavor struct {
+	Id     string `json:"id"`
+	Name   string `json:"name"`
+	Vcpus  int    `json:"vcpus"`
+	Ram    int    `json:"ram"`
+	Disk   int    `json:"disk"`
+	Type   string `json:"type"`
+	Region string `json:"region"`
+}
+
+type CloudProjectInstanceResponse struct {
+	Id               string                              `json:"id"`
+	Name             string                              `json:"name"`
+	Region           string                              `json:"region"`
+	AvailabilityZone string                              `json:"availabilityZone"`
+	Status           string                              `json:"status"`
+	Created          string                              `json:"created"`
+	FlavorId         string                              `json:"flavorId"`
+	Flavor           *CloudProjectInstanceFlavor         `json:"flavor"`
+	ImageId          string                              `json:"imageId"`
+	SshKeyId         *string                             `json:"sshKeyId"`
+	MonthlyBilling   *CloudProjectInstanceMonthlyBilling `json:"monthlyBilling"`
+	IpAddresses      []CloudProjectInstanceIpAddress     `json:"ipAddresses"`
+}
+
+type CloudProjectInstanceMonthlyBilling struct {
+	Since  string `json:"since"`
+	Status string `json:"status"`
+}
+
+// PublicIpv4 returns the first public IPv4 address of the instance
+func (v CloudProjectInstanceResponse) PublicIpv4() string {
+	for _, ip := range v.IpAddresses {
+		if ip.Type == "public" && ip.Version == 4 {
+			return ip.Ip
+		}
+	}
+	return ""
+}
+
+// ToMap returns the attributes of the instance which are known by the API,
+// the creation parameters which aren't returned are left untouched in the state
+func (v CloudProjectInstanceResponse) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["name"] = v.Name
+	obj["region"] = v.Region
+	obj["status"] = v.Status
+	obj["created_at"] = v.Created
+	obj["flavor_id"] = v.FlavorId
+	obj["image_id"] = v.ImageId
+	obj["public_ipv4"] = v.PublicIpv4()
+
+	if v.AvailabilityZone != "" {
+		obj["availability_zone"] = v.AvailabilityZone
+	}
+	if v.Flavor != nil {
+		obj["flavor_name"] = v.Flavor.Name
+	}
+
+	obj["billing_period"] = cloudProjectInstanceBillingPeriodHourly
+	if v.MonthlyBilling != nil {
+		obj["billing_period"] = cloudProjectInstanceBillingPeriodMonthly
+	}
+
+	ipAddresses := make([]map[string]interface{}, 0, len(v.IpAddresses))
+	for _, ip := range v.IpAddresses {
+		ipAddresses = append(ipAddresses, ip.ToMap())
+	}
+	obj["ip_addresses"] = ipAddresses
+
+	return obj
+}
+
+// NetworkToMap returns the networks of the instance deduced from its IP addresses.
+// The subnet of the private network isn't known.
+func (v CloudProjectInstanceResponse) NetworkToMap() []map[string]interface{} {
+	network := map[string]interface{}{
+		"public":  false,
+		"private": []map[string]interface{}{},
+	}
+	for _, ip := range v.IpAddresses {
+		switch {
+		case ip.Type == "public":
+			network["public"] = true
+		case ip.Type == "private" && len(network["private"].([]map[string]interface{})) == 0:
+			network["private"] = []map[string]interface{}{{"network_id": ip.NetworkId}}
+		}
+	}
+	return []map[string]interface{}{network}
+}
+
+type CloudProjectInstanceCreateFlavor struct {
+	Id string `json:"id"`
+}
+
+type CloudProjectInstanceCreateBootFrom struct {
+	ImageId string `json:"imageId"`
+}
+
+type CloudProjectInstanceCreateSshKey struct {
+	Name string `json:"name"`
+}
+
+type CloudProjectInstanceCreatePrivateNetworkRef struct {
+	Id       string  `json:"id"`
+	SubnetId *string `json:"subnetId,omitempty"`
+}
+
+type CloudProjectInstanceCreatePrivateNetwork struct {
+	Network CloudProjectInstanceCreatePrivateNetworkRef `json:"network"`
+}
+
+type CloudProjectInstanceCreateNetwork struct {
+	Public  bool                                      `json:"public"`
+	Private *CloudProjectInstanceCreatePrivateNetwork `json:"private,omitempty"`
+}
+
+type CloudProjectInstanceAutobackup struct {
+	Cron     string `json:"cron"`
+	Rotation int    `json:"rotation"`
+}
+
+type CloudProjectInstanceCreateOpts struct {
+	Name             string                             `json:"name"`
+	Flavor           CloudProjectInstanceCreateFlavor   `json:"flavor"`
+	BootFrom         CloudProjectInstanceCreateBootFrom `json:"bootFrom"`
+	SshKey           *CloudProjectInstanceCreateSshKey  `json:"sshKey,omitempty"`
+	UserData         *string                            `json:"userData,omitempty"`
+	Network          CloudProjectInstanceCreateNetwork  `json:"network"`
+	BillingPeriod    string                             `json:"billingPeriod"`
+	Autobackup       *CloudProjectInstanceAutobackup    `json:"autobackup,omitempty"`
+	AvailabilityZone *string                            `json:"availabilityZone,omitempty"`
+}
+
+func (opts *CloudProjectInstanceCreateOpts) FromResource(d *schema.ResourceData) *CloudProjectInstanceCreateOpts {
+	opts.Name = d.Get("name").(string)
+	opts.Flavor.Id = d.Get("flavor_id").(string)
+	opts.BootFrom.ImageId = d.Get("image_id").(string)
+	opts.UserData = helpers.GetNilStringPointerFromData(d, "user_data")
+	opts.BillingPeriod = d.Get("billing_period").(string)
+	opts.AvailabilityZone = helpers.GetNilStringPointerFromData(d, "availability_zone")
+
+	if sshKey := helpers.GetNilStringPointerFromData(d, "ssh_key_name"); sshKey != nil {
+		opts.SshKey = &CloudProjectInstanceCreateSshKey{Name: *sshKey}
+	}
+
+	opts.Network.Public = d.Get("network.0.public").(bool)
+	if networkId := helpers.GetNilStringPointerFromData(d, "network.0.private.0.network_id"); networkId != nil {
+		opts.Network.Private = &CloudProjectInstanceCreatePrivateNetwork{
+			Network: CloudProjectInstanceCreatePrivateNetworkRef{
+				Id:       *networkId,
+				SubnetId: helpers.GetNilStringPointerFromData(d, "network.0.private.0.subnet_id"),
+			},
+		}
+	}
+
+	if _, ok := d.GetOk("autobackup"); ok {
+		opts.Autobackup = &CloudProjectInstanceAutobackup{
+			Cron:     d.Get("autobackup.0.cron").(string),
+			Rotation: d.Get("autobackup.0.rotation").(int),
+		}
+	}
+
+	return opts
+}
+
+func (opts *CloudProjectInstanceCreateOpts) String() string {
+	return fmt.Sprintf("name: %s, flavor: %s, image: %s, billing: %s", opts.Name, opts.Flavor.Id, opts.BootFrom.ImageId, opts.BillingPeriod)
+}
+
+type CloudProjectInstanceUpdateOpts struct {
+	InstanceName string `json:"instanceName"`
+}
+
+type CloudProjectInstanceResizeOpts struct {
+	FlavorId string `json:"flavorId"`
+}
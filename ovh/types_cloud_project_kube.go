@@ -28,16 +28,17 @@ type CloudProjectKubeUpdateLoadBalancersSubnetIdOpts struct {
 }
 
 type CloudProjectKubeCreateOpts struct {
-	Name                        *string                      `json:"name,omitempty"`
-	PrivateNetworkId            *string                      `json:"privateNetworkId,omitempty"`
-	PrivateNetworkConfiguration *privateNetworkConfiguration `json:"privateNetworkConfiguration,omitempty"`
-	Region                      string                       `json:"region"`
-	Version                     *string                      `json:"version,omitempty"`
-	UpdatePolicy                *string                      `json:"updatePolicy,omitempty"`
-	Customization               *Customization               `json:"customization,omitempty"`
-	KubeProxyMode               *string                      `json:"kubeProxyMode,omitempty"`
-	LoadBalancersSubnetId       *string                      `json:"loadBalancersSubnetId,omitempty"`
-	NodesSubnetId               *string                      `json:"nodesSubnetId,omitempty"`
+	Name                        *string                             `json:"name,omitempty"`
+	PrivateNetworkId            *string                             `json:"privateNetworkId,omitempty"`
+	PrivateNetworkConfiguration *privateNetworkConfiguration        `json:"privateNetworkConfiguration,omitempty"`
+	Region                      string                              `json:"region"`
+	Version                     *string                             `json:"version,omitempty"`
+	UpdatePolicy                *string                             `json:"updatePolicy,omitempty"`
+	Customization               *Customization                      `json:"customization,omitempty"`
+	KubeProxyMode               *string                             `json:"kubeProxyMode,omitempty"`
+	LoadBalancersSubnetId       *string                             `json:"loadBalancersSubnetId,omitempty"`
+	NodesSubnetId               *string                             `json:"nodesSubnetId,omitempty"`
+	NodePool                    *CloudProjectKubeNodePoolCreateOpts `json:"nodepool,omitempty"`
 }
 
 type Customization struct {
@@ -73,7 +74,7 @@ type AdmissionPlugins struct {
 	Disabled *[]string `json:"disabled,omitempty"`
 }
 
-func (opts *CloudProjectKubeCreateOpts) FromResource(d *schema.ResourceData) {
+func (opts *CloudProjectKubeCreateOpts) FromResource(d *schema.ResourceData) error {
 	opts.Region = d.Get("region").(string)
 	opts.Version = helpers.GetNilStringPointerFromData(d, "version")
 	opts.Name = helpers.GetNilStringPointerFromData(d, "name")
@@ -98,6 +99,16 @@ func (opts *CloudProjectKubeCreateOpts) FromResource(d *schema.ResourceData) {
 		log.Printf("[DEBUG] Using new syntax for api server customization")
 		opts.Customization.APIServer = loadApiServerCustomization(d.Get(kubeClusterCustomizationApiServerKey))
 	}
+
+	if _, ok := d.GetOk(kubeClusterInitialNodePoolKey); ok {
+		nodePool, err := (&CloudProjectKubeNodePoolCreateOpts{}).fromResourceWithPrefix(d, kubeClusterInitialNodePoolKey+".0.")
+		if err != nil {
+			return err
+		}
+		opts.NodePool = nodePool
+	}
+
+	return nil
 }
 
 func userIsUsingDeprecatedCustomizationSyntax(d *schema.ResourceData) bool {
@@ -439,6 +450,19 @@ type CloudProjectKubeNodeResponse struct {
 	Version    string `json:"version"`
 }
 
+type CloudProjectKubeEtcdUsageResponse struct {
+	Quota int `json:"quota"`
+	Usage int `json:"usage"`
+}
+
+// UsagePercent returns the space used by etcd in percent of its quota
+func (v CloudProjectKubeEtcdUsageResponse) UsagePercent() float64 {
+	if v.Quota == 0 {
+		return 0
+	}
+	return float64(v.Usage) * 100 / float64(v.Quota)
+}
+
 func (v CloudProjectKubeNodeResponse) ToMap() map[string]interface{} {
 	obj := make(map[string]interface{})
 	obj["created_at"] = v.CreatedAt
@@ -0,0 +1,58 @@
+package ovh
+
+import (
+	"encoding/json"
+)
+
+type CloudProjectKubeFlavor struct {
+	Category string `json:"category"`
+	GPUs     int    `json:"gpus"`
+	Name     string `json:"name"`
+	RAM      int    `json:"ram"`
+	State    string `json:"state"`
+	VCPUs    int    `json:"vCPUs"`
+}
+
+func (v CloudProjectKubeFlavor) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["category"] = v.Category
+	obj["gpus"] = v.GPUs
+	obj["name"] = v.Name
+	obj["ram"] = v.RAM
+	obj["state"] = v.State
+	obj["vcpus"] = v.VCPUs
+	return obj
+}
+
+type CloudProjectKubeVersion struct {
+	Version              string  `json:"version"`
+	EndOfStandardSupport *string `json:"endOfStandardSupport,omitempty"`
+	EndOfLife            *string `json:"endOfLife,omitempty"`
+}
+
+// UnmarshalJSON accepts both a plain version string and a version object,
+// end-of-life dates are only returned by the API for some versions
+func (v *CloudProjectKubeVersion) UnmarshalJSON(b []byte) error {
+	var version string
+	if err := json.Unmarshal(b, &version); err == nil {
+		v.Version = version
+		return nil
+	}
+
+	type versionAlias CloudProjectKubeVersion
+	return json.Unmarshal(b, (*versionAlias)(v))
+}
+
+func (v CloudProjectKubeVersion) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["version"] = v.Version
+	obj["end_of_standard_support"] = ""
+	if v.EndOfStandardSupport != nil {
+		obj["end_of_standard_support"] = *v.EndOfStandardSupport
+	}
+	obj["end_of_life"] = ""
+	if v.EndOfLife != nil {
+		obj["end_of_life"] = *v.EndOfLife
+	}
+	return obj
+}
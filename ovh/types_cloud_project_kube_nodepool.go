@@ -18,16 +18,18 @@ const (
 )
 
 type CloudProjectKubeNodePoolCreateOpts struct {
-	AntiAffinity  *bool                                `json:"antiAffinity,omitempty"`
-	Autoscale     *bool                                `json:"autoscale,omitempty"`
-	DesiredNodes  *int                                 `json:"desiredNodes,omitempty"`
-	FlavorName    string                               `json:"flavorName"`
-	MaxNodes      *int                                 `json:"maxNodes,omitempty"`
-	MinNodes      *int                                 `json:"minNodes,omitempty"`
-	MonthlyBilled *bool                                `json:"monthlyBilled,omitempty"`
-	Name          *string                              `json:"name,omitempty"`
-	Autoscaling   *CloudProjectKubeNodePoolAutoscaling `json:"autoscaling,omitempty"`
-	Template      *CloudProjectKubeNodePoolTemplate    `json:"template,omitempty"`
+	AntiAffinity      *bool                                `json:"antiAffinity,omitempty"`
+	Autoscale         *bool                                `json:"autoscale,omitempty"`
+	AvailabilityZones []string                             `json:"availabilityZones,omitempty"`
+	AttachFloatingIps *CloudProjectKubeNodePoolFloatingIps `json:"attachFloatingIps,omitempty"`
+	DesiredNodes      *int                                 `json:"desiredNodes,omitempty"`
+	FlavorName        string                               `json:"flavorName"`
+	MaxNodes          *int                                 `json:"maxNodes,omitempty"`
+	MinNodes          *int                                 `json:"minNodes,omitempty"`
+	MonthlyBilled     *bool                                `json:"monthlyBilled,omitempty"`
+	Name              *string                              `json:"name,omitempty"`
+	Autoscaling       *CloudProjectKubeNodePoolAutoscaling `json:"autoscaling,omitempty"`
+	Template          *CloudProjectKubeNodePoolTemplate    `json:"template,omitempty"`
 }
 
 type TaintEffectType int
@@ -54,6 +56,10 @@ type CloudProjectKubeNodePoolTemplate struct {
 	Spec     CloudProjectKubeNodePoolTemplateSpec     `json:"spec"`
 }
 
+type CloudProjectKubeNodePoolFloatingIps struct {
+	Enabled bool `json:"enabled"`
+}
+
 type CloudProjectKubeNodePoolAutoscaling struct {
 	ScaleDownUtilizationThreshold *float64 `json:"scaleDownUtilizationThreshold,omitempty"`
 	ScaleDownUnneededTimeSeconds  *int     `json:"scaleDownUnneededTimeSeconds,omitempty"`
@@ -61,12 +67,13 @@ type CloudProjectKubeNodePoolAutoscaling struct {
 }
 
 type CloudProjectKubeNodePoolUpdateOpts struct {
-	Autoscale    *bool                                `json:"autoscale,omitempty"`
-	DesiredNodes *int                                 `json:"desiredNodes,omitempty"`
-	MaxNodes     *int                                 `json:"maxNodes,omitempty"`
-	MinNodes     *int                                 `json:"minNodes,omitempty"`
-	Autoscaling  *CloudProjectKubeNodePoolAutoscaling `json:"autoscaling,omitempty"`
-	Template     *CloudProjectKubeNodePoolTemplate    `json:"template,omitempty"`
+	Autoscale     *bool                                `json:"autoscale,omitempty"`
+	DesiredNodes  *int                                 `json:"desiredNodes,omitempty"`
+	MaxNodes      *int                                 `json:"maxNodes,omitempty"`
+	MinNodes      *int                                 `json:"minNodes,omitempty"`
+	Autoscaling   *CloudProjectKubeNodePoolAutoscaling `json:"autoscaling,omitempty"`
+	Template      *CloudProjectKubeNodePoolTemplate    `json:"template,omitempty"`
+	NodesToRemove []string                             `json:"nodesToRemove,omitempty"`
 }
 
 var toString = map[TaintEffectType]string{
@@ -84,31 +91,58 @@ var TaintEffecTypeToID = map[string]TaintEffectType{
 }
 
 func GetAutoscalingOpts(d *schema.ResourceData) (*CloudProjectKubeNodePoolAutoscaling, error) {
+	return getAutoscalingOptsWithPrefix(d, "")
+}
+
+func getAutoscalingOptsWithPrefix(d *schema.ResourceData, prefix string) (*CloudProjectKubeNodePoolAutoscaling, error) {
 	var autoscaling CloudProjectKubeNodePoolAutoscaling
 	var e error
-	autoscaling.ScaleDownUtilizationThreshold, e = helpers.GetNilFloat64PointerFromData(d, "autoscaling_scale_down_utilization_threshold")
-	autoscaling.ScaleDownUnneededTimeSeconds = helpers.GetNilIntPointerFromData(d, "autoscaling_scale_down_unneeded_time_seconds")
-	autoscaling.ScaleDownUnreadyTimeSeconds = helpers.GetNilIntPointerFromData(d, "autoscaling_scale_down_unready_time_seconds")
+	autoscaling.ScaleDownUtilizationThreshold, e = helpers.GetNilFloat64PointerFromData(d, prefix+"autoscaling_scale_down_utilization_threshold")
+	autoscaling.ScaleDownUnneededTimeSeconds = helpers.GetNilIntPointerFromData(d, prefix+"autoscaling_scale_down_unneeded_time_seconds")
+	autoscaling.ScaleDownUnreadyTimeSeconds = helpers.GetNilIntPointerFromData(d, prefix+"autoscaling_scale_down_unready_time_seconds")
 	return &autoscaling, e
 }
 
 func (opts *CloudProjectKubeNodePoolCreateOpts) FromResource(d *schema.ResourceData) (*CloudProjectKubeNodePoolCreateOpts, error) {
-	opts.Autoscale = helpers.GetNilBoolPointerFromData(d, "autoscale")
-	opts.AntiAffinity = helpers.GetNilBoolPointerFromData(d, "anti_affinity")
-	opts.DesiredNodes = helpers.GetNilIntPointerFromDataAndNilIfNotPresent(d, "desired_nodes")
-	opts.FlavorName = d.Get("flavor_name").(string)
-	opts.MaxNodes = helpers.GetNilIntPointerFromDataAndNilIfNotPresent(d, "max_nodes")
-	opts.MinNodes = helpers.GetNilIntPointerFromDataAndNilIfNotPresent(d, "min_nodes")
-	opts.MonthlyBilled = helpers.GetNilBoolPointerFromData(d, "monthly_billed")
-	opts.Name = helpers.GetNilStringPointerFromData(d, "name")
+	if _, err := opts.fromResourceWithPrefix(d, ""); err != nil {
+		return nil, err
+	}
 
-	template, err := loadNodelPoolTemplateFromResource(d.Get("template"))
+	zones, err := helpers.StringsFromSchema(d, "availability_zones")
+	if err != nil {
+		return nil, err
+	}
+	opts.AvailabilityZones = zones
+
+	if v, ok := d.GetOk("attach_floating_ips"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		floatingIps := v.([]interface{})[0].(map[string]interface{})
+		opts.AttachFloatingIps = &CloudProjectKubeNodePoolFloatingIps{
+			Enabled: floatingIps["enabled"].(bool),
+		}
+	}
+
+	return opts, nil
+}
+
+// fromResourceWithPrefix reads the nodepool attributes found under prefix,
+// e.g. "initial_nodepool.0." for the nodepool created along with a cluster
+func (opts *CloudProjectKubeNodePoolCreateOpts) fromResourceWithPrefix(d *schema.ResourceData, prefix string) (*CloudProjectKubeNodePoolCreateOpts, error) {
+	opts.Autoscale = helpers.GetNilBoolPointerFromData(d, prefix+"autoscale")
+	opts.AntiAffinity = helpers.GetNilBoolPointerFromData(d, prefix+"anti_affinity")
+	opts.DesiredNodes = helpers.GetNilIntPointerFromDataAndNilIfNotPresent(d, prefix+"desired_nodes")
+	opts.FlavorName = d.Get(prefix + "flavor_name").(string)
+	opts.MaxNodes = helpers.GetNilIntPointerFromDataAndNilIfNotPresent(d, prefix+"max_nodes")
+	opts.MinNodes = helpers.GetNilIntPointerFromDataAndNilIfNotPresent(d, prefix+"min_nodes")
+	opts.MonthlyBilled = helpers.GetNilBoolPointerFromData(d, prefix+"monthly_billed")
+	opts.Name = helpers.GetNilStringPointerFromData(d, prefix+"name")
+
+	template, err := loadNodelPoolTemplateFromResource(d.Get(prefix + "template"))
 
 	if err != nil {
 		return nil, err
 	}
 
-	autoscaling, err := GetAutoscalingOpts(d)
+	autoscaling, err := getAutoscalingOptsWithPrefix(d, prefix)
 
 	if err != nil {
 		return nil, err
@@ -138,110 +172,73 @@ func loadNodelPoolTemplateFromResource(i interface{}) (*CloudProjectKubeNodePool
 		},
 	}
 
-	templateSet := i.(*schema.Set).List()
-	if templateSet == nil || len(templateSet) == 0 {
+	templateList := i.([]interface{})
+	if len(templateList) == 0 || templateList[0] == nil {
 		return &template, nil
 	}
+	templateObject := templateList[0].(map[string]interface{})
 
-	// Due to this bug https://github.com/hashicorp/terraform-plugin-sdk/pull/1042
-	// when updating the 'template' object there is two objects, one is empty, take the not empty one
-	templateObject := templateSet[0].(map[string]interface{}) // by default take the first one
-	for _, to := range templateSet {
-		var (
-			annotations map[string]interface{}
-			labels      map[string]interface{}
-			finalizers  []interface{}
-			taints      []interface{}
-		)
-
-		metadataSet := to.(map[string]interface{})["metadata"].(*schema.Set).List()
-		if len(metadataSet) > 0 {
-			metadata := metadataSet[0].(map[string]interface{})
-			annotations = metadata["annotations"].(map[string]interface{})
-			labels = metadata["labels"].(map[string]interface{})
-			finalizers = metadata["finalizers"].([]interface{})
-		}
+	// metadata
+	if metadataList := templateObject["metadata"].([]interface{}); len(metadataList) > 0 && metadataList[0] != nil {
+		metadata := metadataList[0].(map[string]interface{})
 
-		specSet := to.(map[string]interface{})["spec"].(*schema.Set).List()
-		if len(specSet) > 0 {
-			spec := specSet[0].(map[string]interface{})
-			taints = spec["taints"].([]interface{})
+		// metadata.annotations
+		for k, v := range metadata["annotations"].(map[string]interface{}) {
+			template.Metadata.Annotations[k] = v.(string)
 		}
 
-		if len(annotations) == 0 && len(labels) == 0 && len(finalizers) == 0 && len(taints) == 0 {
-			continue
+		// metadata.finalizers
+		for _, finalizer := range metadata["finalizers"].([]interface{}) {
+			template.Metadata.Finalizers = append(template.Metadata.Finalizers, finalizer.(string))
 		}
 
-		// We found the not empty object
-		templateObject = to.(map[string]interface{})
-	}
-
-	// metadata
-	{
-		metadataSet := templateObject["metadata"].(*schema.Set).List()
-		if len(metadataSet) > 0 {
-			metadata := metadataSet[0].(map[string]interface{})
-
-			// metadata.annotations
-			annotations := metadata["annotations"].(map[string]interface{})
-			if len(annotations) > 0 {
-				for k, v := range annotations {
-					template.Metadata.Annotations[k] = v.(string)
-				}
-			}
-
-			// metadata.finalizers
-			finalizers := metadata["finalizers"].([]interface{})
-			if len(finalizers) > 0 {
-				for _, finalizer := range finalizers {
-					template.Metadata.Finalizers = append(template.Metadata.Finalizers, finalizer.(string))
-				}
-			}
-
-			// metadata.labels
-			labels := metadata["labels"].(map[string]interface{})
-			if len(labels) > 0 {
-				for k, v := range labels {
-					template.Metadata.Labels[k] = v.(string)
-				}
-			}
+		// metadata.labels
+		for k, v := range metadata["labels"].(map[string]interface{}) {
+			template.Metadata.Labels[k] = v.(string)
 		}
 	}
 
 	// spec
-	{
-		specSet := templateObject["spec"].(*schema.Set).List()
-		if len(specSet) > 0 {
-			spec := specSet[0].(map[string]interface{})
-
-			// spec.taints
-			taints := spec["taints"].([]interface{})
-			for _, taint := range taints {
-				effectString := taint.(map[string]interface{})["effect"].(string)
-				effect := TaintEffecTypeToID[effectString]
-				if effect == NotATaint {
-					return nil, fmt.Errorf("effect: %s is not a allowable taint %#v", effectString, TaintEffecTypeToID)
-				}
+	if specList := templateObject["spec"].([]interface{}); len(specList) > 0 && specList[0] != nil {
+		spec := specList[0].(map[string]interface{})
 
-				taintObject := Taint{
-					Effect: effect,
-					Key:    taint.(map[string]interface{})["key"].(string),
-				}
-				if taint.(map[string]interface{})["value"] != nil {
-					taintObject.Value = taint.(map[string]interface{})["value"].(string)
-				}
+		// spec.taints
+		for _, taint := range spec["taints"].([]interface{}) {
+			taintMap := taint.(map[string]interface{})
 
-				template.Spec.Taints = append(template.Spec.Taints, taintObject)
+			effectString := taintMap["effect"].(string)
+			effect := TaintEffecTypeToID[effectString]
+			if effect == NotATaint {
+				return nil, fmt.Errorf("effect: %s is not a allowable taint %#v", effectString, TaintEffecTypeToID)
 			}
 
-			// spec.unschedulable
-			template.Spec.Unschedulable = spec["unschedulable"].(bool)
+			template.Spec.Taints = append(template.Spec.Taints, Taint{
+				Effect: effect,
+				Key:    taintMap["key"].(string),
+				Value:  taintMap["value"].(string),
+			})
 		}
+
+		// spec.unschedulable
+		template.Spec.Unschedulable = spec["unschedulable"].(bool)
 	}
 
 	return &template, nil
 }
 
+// filterNodePoolTemplateLabels only keeps the labels whose key is in managed.
+// The API may add its own labels to the template, they must not show up as a diff.
+func filterNodePoolTemplateLabels(labels map[string]string, managed map[string]interface{}) map[string]string {
+	filtered := make(map[string]string, len(managed))
+	for k, v := range labels {
+		if _, ok := managed[k]; ok {
+			filtered[k] = v
+		}
+	}
+
+	return filtered
+}
+
 func (s *CloudProjectKubeNodePoolCreateOpts) String() string {
 	return fmt.Sprintf("%s(%s): %d/%d/%d", *s.Name, s.FlavorName, *s.DesiredNodes, *s.MinNodes, *s.MaxNodes)
 }
@@ -272,7 +269,10 @@ func (e *TaintEffectType) UnmarshalJSON(b []byte) error {
 
 func (opts *CloudProjectKubeNodePoolUpdateOpts) FromResource(d *schema.ResourceData) (*CloudProjectKubeNodePoolUpdateOpts, error) {
 	opts.Autoscale = helpers.GetNilBoolPointerFromData(d, "autoscale")
-	opts.DesiredNodes = helpers.GetNilIntPointerFromDataAndNilIfNotPresent(d, "desired_nodes")
+	// The desired nodes are only sent when changed, not to override the cluster autoscaler
+	if d.HasChange("desired_nodes") {
+		opts.DesiredNodes = helpers.GetNilIntPointerFromDataAndNilIfNotPresent(d, "desired_nodes")
+	}
 	opts.MaxNodes = helpers.GetNilIntPointerFromDataAndNilIfNotPresent(d, "max_nodes")
 	opts.MinNodes = helpers.GetNilIntPointerFromDataAndNilIfNotPresent(d, "min_nodes")
 	var autoscaling CloudProjectKubeNodePoolAutoscaling
@@ -294,35 +294,47 @@ func (opts *CloudProjectKubeNodePoolUpdateOpts) FromResource(d *schema.ResourceD
 }
 
 func (s *CloudProjectKubeNodePoolUpdateOpts) String() string {
-	return fmt.Sprintf("%d/%d/%d", *s.DesiredNodes, *s.MinNodes, *s.MaxNodes)
+	size := func(v *int) string {
+		if v == nil {
+			return "-"
+		}
+		return fmt.Sprint(*v)
+	}
+	return fmt.Sprintf("%s/%s/%s", size(s.DesiredNodes), size(s.MinNodes), size(s.MaxNodes))
 }
 
 type CloudProjectKubeNodePoolResponse struct {
-	Autoscale      bool                                `json:"autoscale"`
-	AntiAffinity   bool                                `json:"antiAffinity"`
-	AvailableNodes int                                 `json:"availableNodes"`
-	CreatedAt      string                              `json:"createdAt"`
-	CurrentNodes   int                                 `json:"currentNodes"`
-	DesiredNodes   int                                 `json:"desiredNodes"`
-	Flavor         string                              `json:"flavor"`
-	Id             string                              `json:"id"`
-	MaxNodes       int                                 `json:"maxNodes"`
-	MinNodes       int                                 `json:"minNodes"`
-	MonthlyBilled  bool                                `json:"monthlyBilled"`
-	Name           string                              `json:"name"`
-	ProjectId      string                              `json:"projectId"`
-	SizeStatus     string                              `json:"sizeStatus"`
-	Status         string                              `json:"status"`
-	UpToDateNodes  int                                 `json:"upToDateNodes"`
-	UpdatedAt      string                              `json:"updatedAt"`
-	Autoscaling    CloudProjectKubeNodePoolAutoscaling `json:"autoscaling"`
-	Template       *CloudProjectKubeNodePoolTemplate   `json:"template,omitempty"`
+	AvailabilityZones []string                             `json:"availabilityZones"`
+	AttachFloatingIps *CloudProjectKubeNodePoolFloatingIps `json:"attachFloatingIps,omitempty"`
+	Autoscale         bool                                 `json:"autoscale"`
+	AntiAffinity      bool                                 `json:"antiAffinity"`
+	AvailableNodes    int                                  `json:"availableNodes"`
+	CreatedAt         string                               `json:"createdAt"`
+	CurrentNodes      int                                  `json:"currentNodes"`
+	DesiredNodes      int                                  `json:"desiredNodes"`
+	Flavor            string                               `json:"flavor"`
+	Id                string                               `json:"id"`
+	MaxNodes          int                                  `json:"maxNodes"`
+	MinNodes          int                                  `json:"minNodes"`
+	MonthlyBilled     bool                                 `json:"monthlyBilled"`
+	Name              string                               `json:"name"`
+	ProjectId         string                               `json:"projectId"`
+	SizeStatus        string                               `json:"sizeStatus"`
+	Status            string                               `json:"status"`
+	UpToDateNodes     int                                  `json:"upToDateNodes"`
+	UpdatedAt         string                               `json:"updatedAt"`
+	Autoscaling       CloudProjectKubeNodePoolAutoscaling  `json:"autoscaling"`
+	Template          *CloudProjectKubeNodePoolTemplate    `json:"template,omitempty"`
 }
 
 func (v CloudProjectKubeNodePoolResponse) ToMap() map[string]interface{} {
 	obj := make(map[string]interface{})
 	obj["anti_affinity"] = v.AntiAffinity
 	obj["autoscale"] = v.Autoscale
+	obj["availability_zones"] = v.AvailabilityZones
+	if v.AttachFloatingIps != nil {
+		obj["attach_floating_ips"] = []map[string]interface{}{{"enabled": v.AttachFloatingIps.Enabled}}
+	}
 	obj["available_nodes"] = v.AvailableNodes
 	obj["created_at"] = v.CreatedAt
 	obj["current_nodes"] = v.CurrentNodes
@@ -0,0 +1,381 @@
+package ovh
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+type CloudProjectLoadbalancerNetworkRef struct {
+	Id       string `json:"id"`
+	SubnetId string `json:"subnetId"`
+}
+
+type CloudProjectLoadbalancerFloatingIpRef struct {
+	Id string `json:"id"`
+}
+
+type CloudProjectLoadbalancerFloatingIpCreate struct {
+	Description string `json:"description"`
+}
+
+type CloudProjectLoadbalancerPrivateNetwork struct {
+	Network          CloudProjectLoadbalancerNetworkRef        `json:"network"`
+	FloatingIp       *CloudProjectLoadbalancerFloatingIpRef    `json:"floatingIp,omitempty"`
+	FloatingIpCreate *CloudProjectLoadbalancerFloatingIpCreate `json:"floatingIpCreate,omitempty"`
+}
+
+type CloudProjectLoadbalancerNetwork struct {
+	Private CloudProjectLoadbalancerPrivateNetwork `json:"private"`
+}
+
+type CloudProjectLoadbalancerCreateOpts struct {
+	Name     string                          `json:"name"`
+	FlavorId string                          `json:"flavorId"`
+	Network  CloudProjectLoadbalancerNetwork `json:"network"`
+}
+
+func (opts *CloudProjectLoadbalancerCreateOpts) FromResource(d *schema.ResourceData) *CloudProjectLoadbalancerCreateOpts {
+	opts.Name = d.Get("name").(string)
+	opts.FlavorId = d.Get("flavor_id").(string)
+	opts.Network.Private.Network = CloudProjectLoadbalancerNetworkRef{
+		Id:       d.Get("network_id").(string),
+		SubnetId: d.Get("subnet_id").(string),
+	}
+
+	if floatingIpId := helpers.GetNilStringPointerFromData(d, "floating_ip_id"); floatingIpId != nil {
+		opts.Network.Private.FloatingIp = &CloudProjectLoadbalancerFloatingIpRef{Id: *floatingIpId}
+	} else if d.Get("create_floating_ip").(bool) {
+		opts.Network.Private.FloatingIpCreate = &CloudProjectLoadbalancerFloatingIpCreate{
+			Description: fmt.Sprintf("Floating IP of load balancer %s", opts.Name),
+		}
+	}
+
+	return opts
+}
+
+func (opts *CloudProjectLoadbalancerCreateOpts) String() string {
+	return fmt.Sprintf("name: %s, flavor: %s, network: %s, subnet: %s",
+		opts.Name, opts.FlavorId, opts.Network.Private.Network.Id, opts.Network.Private.Network.SubnetId)
+}
+
+type CloudProjectLoadbalancerUpdateOpts struct {
+	Name string `json:"name"`
+}
+
+type CloudProjectLoadbalancerFloatingIp struct {
+	Id string `json:"id"`
+	Ip string `json:"ip"`
+}
+
+type CloudProjectLoadbalancerResponse struct {
+	Id                 string                              `json:"id"`
+	Name               string                              `json:"name"`
+	FlavorId           string                              `json:"flavorId"`
+	Region             string                              `json:"region"`
+	FloatingIp         *CloudProjectLoadbalancerFloatingIp `json:"floatingIp"`
+	OperatingStatus    string                              `json:"operatingStatus"`
+	ProvisioningStatus string                              `json:"provisioningStatus"`
+	VipAddress         string                              `json:"vipAddress"`
+	VipNetworkId       string                              `json:"vipNetworkId"`
+	VipSubnetId        string                              `json:"vipSubnetId"`
+	CreatedAt          string                              `json:"createdAt"`
+	UpdatedAt          string                              `json:"updatedAt"`
+}
+
+func (v CloudProjectLoadbalancerResponse) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["name"] = v.Name
+	obj["flavor_id"] = v.FlavorId
+	obj["network_id"] = v.VipNetworkId
+	obj["subnet_id"] = v.VipSubnetId
+	obj["operating_status"] = v.OperatingStatus
+	obj["provisioning_status"] = v.ProvisioningStatus
+	obj["vip_address"] = v.VipAddress
+	obj["created_at"] = v.CreatedAt
+	obj["updated_at"] = v.UpdatedAt
+
+	floatingIp := make([]map[string]interface{}, 0, 1)
+	if v.FloatingIp != nil {
+		floatingIp = append(floatingIp, map[string]interface{}{
+			"id": v.FloatingIp.Id,
+			"ip": v.FloatingIp.Ip,
+		})
+	}
+	obj["floating_ip"] = floatingIp
+
+	return obj
+}
+
+type CloudProjectLoadbalancerListenerCreateOpts struct {
+	Name           string   `json:"name"`
+	Description    *string  `json:"description,omitempty"`
+	LoadbalancerId string   `json:"loadbalancerId"`
+	Protocol       string   `json:"protocol"`
+	Port           int      `json:"port"`
+	CertificateId  *string  `json:"certificateId,omitempty"`
+	AllowedCidrs   []string `json:"allowedCidrs,omitempty"`
+}
+
+func (opts *CloudProjectLoadbalancerListenerCreateOpts) FromResource(d *schema.ResourceData) (*CloudProjectLoadbalancerListenerCreateOpts, error) {
+	allowedCidrs, err := helpers.StringsFromSchema(d, "allowed_cidrs")
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Name = d.Get("name").(string)
+	opts.Description = helpers.GetNilStringPointerFromData(d, "description")
+	opts.LoadbalancerId = d.Get("loadbalancer_id").(string)
+	opts.Protocol = d.Get("protocol").(string)
+	opts.Port = d.Get("port").(int)
+	opts.CertificateId = helpers.GetNilStringPointerFromData(d, "certificate_id")
+	opts.AllowedCidrs = allowedCidrs
+
+	return opts, nil
+}
+
+type CloudProjectLoadbalancerListenerUpdateOpts struct {
+	Name          string   `json:"name"`
+	Description   string   `json:"description"`
+	CertificateId *string  `json:"certificateId,omitempty"`
+	AllowedCidrs  []string `json:"allowedCidrs"`
+}
+
+func (opts *CloudProjectLoadbalancerListenerUpdateOpts) FromResource(d *schema.ResourceData) (*CloudProjectLoadbalancerListenerUpdateOpts, error) {
+	allowedCidrs, err := helpers.StringsFromSchema(d, "allowed_cidrs")
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Name = d.Get("name").(string)
+	opts.Description = d.Get("description").(string)
+	opts.CertificateId = helpers.GetNilStringPointerFromData(d, "certificate_id")
+	opts.AllowedCidrs = allowedCidrs
+
+	return opts, nil
+}
+
+type CloudProjectLoadbalancerListenerResponse struct {
+	Id                 string   `json:"id"`
+	Name               string   `json:"name"`
+	Description        string   `json:"description"`
+	LoadbalancerId     string   `json:"loadbalancerId"`
+	Protocol           string   `json:"protocol"`
+	Port               int      `json:"port"`
+	CertificateId      *string  `json:"certificateId"`
+	AllowedCidrs       []string `json:"allowedCidrs"`
+	OperatingStatus    string   `json:"operatingStatus"`
+	ProvisioningStatus string   `json:"provisioningStatus"`
+}
+
+func (v CloudProjectLoadbalancerListenerResponse) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["name"] = v.Name
+	obj["description"] = v.Description
+	obj["loadbalancer_id"] = v.LoadbalancerId
+	obj["protocol"] = v.Protocol
+	obj["port"] = v.Port
+	obj["allowed_cidrs"] = v.AllowedCidrs
+	obj["operating_status"] = v.OperatingStatus
+	obj["provisioning_status"] = v.ProvisioningStatus
+
+	if v.CertificateId != nil {
+		obj["certificate_id"] = *v.CertificateId
+	}
+
+	return obj
+}
+
+type CloudProjectLoadbalancerPoolSessionPersistence struct {
+	Type       string  `json:"type"`
+	CookieName *string `json:"cookieName,omitempty"`
+}
+
+type CloudProjectLoadbalancerPoolCreateOpts struct {
+	Name               string                                          `json:"name"`
+	Algorithm          string                                          `json:"algorithm"`
+	Protocol           string                                          `json:"protocol"`
+	LoadbalancerId     *string                                         `json:"loadbalancerId,omitempty"`
+	ListenerId         *string                                         `json:"listenerId,omitempty"`
+	SessionPersistence *CloudProjectLoadbalancerPoolSessionPersistence `json:"sessionPersistence,omitempty"`
+}
+
+func (opts *CloudProjectLoadbalancerPoolCreateOpts) FromResource(d *schema.ResourceData) *CloudProjectLoadbalancerPoolCreateOpts {
+	opts.Name = d.Get("name").(string)
+	opts.Algorithm = d.Get("algorithm").(string)
+	opts.Protocol = d.Get("protocol").(string)
+	opts.LoadbalancerId = helpers.GetNilStringPointerFromData(d, "loadbalancer_id")
+	opts.ListenerId = helpers.GetNilStringPointerFromData(d, "listener_id")
+	opts.SessionPersistence = cloudProjectLoadbalancerPoolSessionPersistenceFromResource(d)
+	return opts
+}
+
+type CloudProjectLoadbalancerPoolUpdateOpts struct {
+	Name               string                                          `json:"name"`
+	Algorithm          string                                          `json:"algorithm"`
+	SessionPersistence *CloudProjectLoadbalancerPoolSessionPersistence `json:"sessionPersistence"`
+}
+
+func (opts *CloudProjectLoadbalancerPoolUpdateOpts) FromResource(d *schema.ResourceData) *CloudProjectLoadbalancerPoolUpdateOpts {
+	opts.Name = d.Get("name").(string)
+	opts.Algorithm = d.Get("algorithm").(string)
+	opts.SessionPersistence = cloudProjectLoadbalancerPoolSessionPersistenceFromResource(d)
+	if opts.SessionPersistence == nil {
+		opts.SessionPersistence = &CloudProjectLoadbalancerPoolSessionPersistence{Type: "disabled"}
+	}
+	return opts
+}
+
+func cloudProjectLoadbalancerPoolSessionPersistenceFromResource(d *schema.ResourceData) *CloudProjectLoadbalancerPoolSessionPersistence {
+	persistenceType := helpers.GetNilStringPointerFromData(d, "session_persistence.0.type")
+	if persistenceType == nil {
+		return nil
+	}
+	return &CloudProjectLoadbalancerPoolSessionPersistence{
+		Type:       *persistenceType,
+		CookieName: helpers.GetNilStringPointerFromData(d, "session_persistence.0.cookie_name"),
+	}
+}
+
+type CloudProjectLoadbalancerPoolResponse struct {
+	Id                 string                                          `json:"id"`
+	Name               string                                          `json:"name"`
+	Algorithm          string                                          `json:"algorithm"`
+	Protocol           string                                          `json:"protocol"`
+	SessionPersistence *CloudProjectLoadbalancerPoolSessionPersistence `json:"sessionPersistence"`
+	OperatingStatus    string                                          `json:"operatingStatus"`
+	ProvisioningStatus string                                          `json:"provisioningStatus"`
+}
+
+func (v CloudProjectLoadbalancerPoolResponse) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["name"] = v.Name
+	obj["algorithm"] = v.Algorithm
+	obj["protocol"] = v.Protocol
+	obj["operating_status"] = v.OperatingStatus
+	obj["provisioning_status"] = v.ProvisioningStatus
+
+	sessionPersistence := make([]map[string]interface{}, 0, 1)
+	if v.SessionPersistence != nil && v.SessionPersistence.Type != "" && v.SessionPersistence.Type != "disabled" {
+		persistence := map[string]interface{}{"type": v.SessionPersistence.Type}
+		if v.SessionPersistence.CookieName != nil {
+			persistence["cookie_name"] = *v.SessionPersistence.CookieName
+		}
+		sessionPersistence = append(sessionPersistence, persistence)
+	}
+	obj["session_persistence"] = sessionPersistence
+
+	return obj
+}
+
+type CloudProjectLoadbalancerHealthMonitorHttpConfiguration struct {
+	UrlPath       string `json:"urlPath,omitempty"`
+	HttpMethod    string `json:"httpMethod,omitempty"`
+	ExpectedCodes string `json:"expectedCodes,omitempty"`
+}
+
+type CloudProjectLoadbalancerHealthMonitor struct {
+	Name              string                                                  `json:"name"`
+	PoolId            string                                                  `json:"poolId,omitempty"`
+	MonitorType       string                                                  `json:"monitorType"`
+	Delay             int                                                     `json:"delay"`
+	Timeout           int                                                     `json:"timeout"`
+	MaxRetries        int                                                     `json:"maxRetries"`
+	MaxRetriesDown    int                                                     `json:"maxRetriesDown"`
+	HttpConfiguration *CloudProjectLoadbalancerHealthMonitorHttpConfiguration `json:"httpConfiguration,omitempty"`
+}
+
+// FromResource returns the health monitor of the pool, or nil when it has none
+func (opts *CloudProjectLoadbalancerHealthMonitor) FromResource(d *schema.ResourceData) *CloudProjectLoadbalancerHealthMonitor {
+	monitorType := helpers.GetNilStringPointerFromData(d, "health_monitor.0.monitor_type")
+	if monitorType == nil {
+		return nil
+	}
+
+	opts.Name = d.Get("name").(string)
+	opts.PoolId = d.Id()
+	opts.MonitorType = *monitorType
+	opts.Delay = d.Get("health_monitor.0.delay").(int)
+	opts.Timeout = d.Get("health_monitor.0.timeout").(int)
+	opts.MaxRetries = d.Get("health_monitor.0.max_retries").(int)
+	opts.MaxRetriesDown = d.Get("health_monitor.0.max_retries_down").(int)
+
+	if opts.MonitorType == "http" || opts.MonitorType == "https" {
+		opts.HttpConfiguration = &CloudProjectLoadbalancerHealthMonitorHttpConfiguration{
+			UrlPath:       d.Get("health_monitor.0.url_path").(string),
+			HttpMethod:    d.Get("health_monitor.0.http_method").(string),
+			ExpectedCodes: d.Get("health_monitor.0.expected_codes").(string),
+		}
+	}
+
+	return opts
+}
+
+type CloudProjectLoadbalancerHealthMonitorResponse struct {
+	CloudProjectLoadbalancerHealthMonitor
+	Id                 string `json:"id"`
+	ProvisioningStatus string `json:"provisioningStatus"`
+}
+
+func (v CloudProjectLoadbalancerHealthMonitorResponse) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["id"] = v.Id
+	obj["monitor_type"] = v.MonitorType
+	obj["delay"] = v.Delay
+	obj["timeout"] = v.Timeout
+	obj["max_retries"] = v.MaxRetries
+	obj["max_retries_down"] = v.MaxRetriesDown
+
+	if v.HttpConfiguration != nil {
+		obj["url_path"] = v.HttpConfiguration.UrlPath
+		obj["http_method"] = v.HttpConfiguration.HttpMethod
+		obj["expected_codes"] = v.HttpConfiguration.ExpectedCodes
+	}
+
+	return obj
+}
+
+type CloudProjectLoadbalancerMember struct {
+	Name         string `json:"name"`
+	Address      string `json:"address"`
+	ProtocolPort int    `json:"protocolPort"`
+	Weight       int    `json:"weight"`
+}
+
+type CloudProjectLoadbalancerMemberCreateOpts struct {
+	Members []CloudProjectLoadbalancerMember `json:"members"`
+}
+
+func (opts *CloudProjectLoadbalancerMemberCreateOpts) FromResource(d *schema.ResourceData) *CloudProjectLoadbalancerMemberCreateOpts {
+	opts.Members = []CloudProjectLoadbalancerMember{{
+		Name:         d.Get("name").(string),
+		Address:      d.Get("address").(string),
+		ProtocolPort: d.Get("protocol_port").(int),
+		Weight:       d.Get("weight").(int),
+	}}
+	return opts
+}
+
+type CloudProjectLoadbalancerMemberUpdateOpts struct {
+	Name   string `json:"name"`
+	Weight int    `json:"weight"`
+}
+
+type CloudProjectLoadbalancerMemberResponse struct {
+	CloudProjectLoadbalancerMember
+	Id                 string `json:"id"`
+	OperatingStatus    string `json:"operatingStatus"`
+	ProvisioningStatus string `json:"provisioningStatus"`
+}
+
+func (v CloudProjectLoadbalancerMemberResponse) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["name"] = v.Name
+	obj["address"] = v.Address
+	obj["protocol_port"] = v.ProtocolPort
+	obj["weight"] = v.Weight
+	obj["operating_status"] = v.OperatingStatus
+	obj["provisioning_status"] = v.ProvisioningStatus
+	return obj
+}
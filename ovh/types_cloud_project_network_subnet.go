@@ -0,0 +1,214 @@
+package ovh
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+type CloudProjectNetworkSubnetHostRoute struct {
+	Destination string `json:"destination"`
+	NextHop     string `json:"nextHop"`
+}
+
+type CloudProjectNetworkSubnetAllocationPool struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// cloudProjectNetworkSubnetDnsNameserversFromResource returns the configured DNS nameservers,
+// an empty list removing all of them
+func cloudProjectNetworkSubnetDnsNameserversFromResource(d *schema.ResourceData) []string {
+	nameservers := make([]string, 0)
+	for _, v := range d.Get("dns_nameservers").([]interface{}) {
+		nameservers = append(nameservers, v.(string))
+	}
+	return nameservers
+}
+
+// cloudProjectNetworkSubnetHostRoutesFromResource returns the configured host routes,
+// an empty list removing all of them
+func cloudProjectNetworkSubnetHostRoutesFromResource(d *schema.ResourceData) []CloudProjectNetworkSubnetHostRoute {
+	routes := make([]CloudProjectNetworkSubnetHostRoute, 0)
+	for _, v := range d.Get("host_routes").([]interface{}) {
+		route := v.(map[string]interface{})
+		routes = append(routes, CloudProjectNetworkSubnetHostRoute{
+			Destination: route["destination"].(string),
+			NextHop:     route["next_hop"].(string),
+		})
+	}
+	return routes
+}
+
+func cloudProjectNetworkSubnetHostRoutesToMap(routes []CloudProjectNetworkSubnetHostRoute) []map[string]interface{} {
+	res := make([]map[string]interface{}, len(routes))
+	for i, route := range routes {
+		res[i] = map[string]interface{}{
+			"destination": route.Destination,
+			"next_hop":    route.NextHop,
+		}
+	}
+	return res
+}
+
+type CloudProjectNetworkSubnetCreateOpts struct {
+	Name            string                                    `json:"name,omitempty"`
+	Cidr            string                                    `json:"cidr"`
+	IpVersion       int                                       `json:"ipVersion"`
+	EnableDhcp      bool                                      `json:"enableDhcp"`
+	EnableGatewayIp bool                                      `json:"enableGatewayIp"`
+	GatewayIp       *string                                   `json:"gatewayIp,omitempty"`
+	DnsNameServers  []string                                  `json:"dnsNameServers,omitempty"`
+	HostRoutes      []CloudProjectNetworkSubnetHostRoute      `json:"hostRoutes,omitempty"`
+	AllocationPools []CloudProjectNetworkSubnetAllocationPool `json:"allocationPools,omitempty"`
+}
+
+func (opts *CloudProjectNetworkSubnetCreateOpts) FromResource(d *schema.ResourceData) *CloudProjectNetworkSubnetCreateOpts {
+	opts.Name = d.Get("name").(string)
+	opts.Cidr = d.Get("cidr").(string)
+	opts.IpVersion = cloudProjectNetworkSubnetIpVersion(opts.Cidr)
+	opts.EnableDhcp = d.Get("dhcp").(bool)
+	opts.EnableGatewayIp = d.Get("enable_gateway_ip").(bool)
+	opts.GatewayIp = helpers.GetNilStringPointerFromData(d, "gateway_ip")
+	opts.DnsNameServers = cloudProjectNetworkSubnetDnsNameserversFromResource(d)
+	opts.HostRoutes = cloudProjectNetworkSubnetHostRoutesFromResource(d)
+
+	for _, v := range d.Get("allocation_pools").([]interface{}) {
+		pool := v.(map[string]interface{})
+		opts.AllocationPools = append(opts.AllocationPools, CloudProjectNetworkSubnetAllocationPool{
+			Start: pool["start"].(string),
+			End:   pool["end"].(string),
+		})
+	}
+	return opts
+}
+
+// CloudProjectNetworkSubnetUpdateOpts are the attributes of a subnet which can be updated in place,
+// through the regional endpoint of the subnet
+type CloudProjectNetworkSubnetUpdateOpts struct {
+	EnableDhcp      bool                                 `json:"enableDhcp"`
+	EnableGatewayIp bool                                 `json:"enableGatewayIp"`
+	GatewayIp       *string                              `json:"gatewayIp,omitempty"`
+	DnsNameServers  []string                             `json:"dnsNameServers"`
+	HostRoutes      []CloudProjectNetworkSubnetHostRoute `json:"hostRoutes"`
+}
+
+type CloudProjectNetworkSubnetResponse struct {
+	Id              string                                    `json:"id"`
+	Name            string                                    `json:"name"`
+	Cidr            string                                    `json:"cidr"`
+	IpVersion       int                                       `json:"ipVersion"`
+	DhcpEnabled     bool                                      `json:"dhcpEnabled"`
+	GatewayIp       *string                                   `json:"gatewayIp"`
+	DnsNameServers  []string                                  `json:"dnsNameServers"`
+	HostRoutes      []CloudProjectNetworkSubnetHostRoute      `json:"hostRoutes"`
+	AllocationPools []CloudProjectNetworkSubnetAllocationPool `json:"allocationPools"`
+}
+
+func (v CloudProjectNetworkSubnetResponse) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["name"] = v.Name
+	obj["cidr"] = v.Cidr
+	obj["ip_version"] = v.IpVersion
+	obj["dhcp"] = v.DhcpEnabled
+	obj["dns_nameservers"] = v.DnsNameServers
+	obj["host_routes"] = cloudProjectNetworkSubnetHostRoutesToMap(v.HostRoutes)
+
+	obj["enable_gateway_ip"] = v.GatewayIp != nil && *v.GatewayIp != ""
+	if v.GatewayIp != nil {
+		obj["gateway_ip"] = *v.GatewayIp
+	} else {
+		obj["gateway_ip"] = ""
+	}
+
+	pools := make([]map[string]interface{}, len(v.AllocationPools))
+	for i, pool := range v.AllocationPools {
+		pools[i] = map[string]interface{}{
+			"start": pool.Start,
+			"end":   pool.End,
+		}
+	}
+	obj["allocation_pools"] = pools
+
+	return obj
+}
+
+// cloudProjectNetworkSubnetIpVersion returns 6 for the IPv6 CIDRs, 4 otherwise
+func cloudProjectNetworkSubnetIpVersion(cidr string) int {
+	if ip, _, err := net.ParseCIDR(cidr); err == nil && ip.To4() == nil {
+		return 6
+	}
+	return 4
+}
+
+// validateCloudProjectNetworkSubnetAddresses checks the addresses of a subnet belong to the family of its CIDR,
+// and the gateway, allocation pools and next hops belong to the CIDR itself
+func validateCloudProjectNetworkSubnetAddresses(cidr, gatewayIp string, pools []CloudProjectNetworkSubnetAllocationPool, dnsNameServers []string, routes []CloudProjectNetworkSubnetHostRoute) error {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return fmt.Errorf("cidr %s is not a valid network: %s", cidr, err)
+	}
+	ipv6 := network.IP.To4() == nil
+
+	sameFamily := func(ip net.IP) bool {
+		return (ip.To4() == nil) == ipv6
+	}
+	inNetwork := func(name, value string) (net.IP, error) {
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return nil, fmt.Errorf("%s %s is not a valid IP", name, value)
+		}
+		if !network.Contains(ip) {
+			return nil, fmt.Errorf("%s %s is not in %s", name, value, cidr)
+		}
+		return ip, nil
+	}
+
+	if gatewayIp != "" {
+		if _, err := inNetwork("gateway_ip", gatewayIp); err != nil {
+			return err
+		}
+	}
+
+	for _, pool := range pools {
+		start, err := inNetwork("allocation pool start", pool.Start)
+		if err != nil {
+			return err
+		}
+		end, err := inNetwork("allocation pool end", pool.End)
+		if err != nil {
+			return err
+		}
+		if bytes.Compare(start.To16(), end.To16()) > 0 {
+			return fmt.Errorf("allocation pool start %s is after its end %s", pool.Start, pool.End)
+		}
+	}
+
+	for _, dns := range dnsNameServers {
+		ip := net.ParseIP(dns)
+		if ip == nil {
+			return fmt.Errorf("dns nameserver %s is not a valid IP", dns)
+		}
+		if !sameFamily(ip) {
+			return fmt.Errorf("dns nameserver %s is not of the IP version of %s", dns, cidr)
+		}
+	}
+
+	for _, route := range routes {
+		destination, _, err := net.ParseCIDR(route.Destination)
+		if err != nil {
+			return fmt.Errorf("host route destination %s is not a valid network: %s", route.Destination, err)
+		}
+		if !sameFamily(destination) {
+			return fmt.Errorf("host route destination %s is not of the IP version of %s", route.Destination, cidr)
+		}
+		if _, err := inNetwork("host route next hop", route.NextHop); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
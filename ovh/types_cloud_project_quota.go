@@ -0,0 +1,155 @@
+package ovh
+
+import (
+	"fmt"
+)
+
+type CloudProjectQuotaInstance struct {
+	MaxCores      int `json:"maxCores"`
+	MaxInstances  int `json:"maxInstances"`
+	MaxRam        int `json:"maxRam"`
+	UsedCores     int `json:"usedCores"`
+	UsedInstances int `json:"usedInstances"`
+	UsedRam       int `json:"usedRAM"`
+}
+
+type CloudProjectQuotaVolume struct {
+	MaxGigabytes   int `json:"maxGigabytes"`
+	UsedGigabytes  int `json:"usedGigabytes"`
+	MaxVolumeCount int `json:"maxVolumeCount"`
+	VolumeCount    int `json:"volumeCount"`
+}
+
+type CloudProjectQuotaNetwork struct {
+	MaxFloatingIps  int `json:"maxFloatingIPs"`
+	UsedFloatingIps int `json:"usedFloatingIPs"`
+	MaxGateways     int `json:"maxGateways"`
+	UsedGateways    int `json:"usedGateways"`
+	MaxNetworks     int `json:"maxNetworks"`
+	UsedNetworks    int `json:"usedNetworks"`
+}
+
+type CloudProjectQuotaLoadbalancer struct {
+	MaxLoadbalancers  int `json:"maxLoadbalancers"`
+	UsedLoadbalancers int `json:"usedLoadbalancers"`
+}
+
+type CloudProjectQuotaResponse struct {
+	Region       string                         `json:"region"`
+	Instance     *CloudProjectQuotaInstance     `json:"instance"`
+	Volume       *CloudProjectQuotaVolume       `json:"volume"`
+	Network      *CloudProjectQuotaNetwork      `json:"network"`
+	Loadbalancer *CloudProjectQuotaLoadbalancer `json:"loadbalancer"`
+}
+
+func (v CloudProjectQuotaResponse) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["region"] = v.Region
+
+	if v.Instance != nil {
+		obj["max_instances"] = v.Instance.MaxInstances
+		obj["used_instances"] = v.Instance.UsedInstances
+		obj["max_cores"] = v.Instance.MaxCores
+		obj["used_cores"] = v.Instance.UsedCores
+		obj["max_ram"] = v.Instance.MaxRam
+		obj["used_ram"] = v.Instance.UsedRam
+	}
+	if v.Volume != nil {
+		obj["max_volumes"] = v.Volume.MaxVolumeCount
+		obj["used_volumes"] = v.Volume.VolumeCount
+		obj["max_volume_gigabytes"] = v.Volume.MaxGigabytes
+		obj["used_volume_gigabytes"] = v.Volume.UsedGigabytes
+	}
+	if v.Network != nil {
+		obj["max_floating_ips"] = v.Network.MaxFloatingIps
+		obj["used_floating_ips"] = v.Network.UsedFloatingIps
+		obj["max_gateways"] = v.Network.MaxGateways
+		obj["used_gateways"] = v.Network.UsedGateways
+		obj["max_networks"] = v.Network.MaxNetworks
+		obj["used_networks"] = v.Network.UsedNetworks
+	}
+	if v.Loadbalancer != nil {
+		obj["max_loadbalancers"] = v.Loadbalancer.MaxLoadbalancers
+		obj["used_loadbalancers"] = v.Loadbalancer.UsedLoadbalancers
+	}
+
+	return obj
+}
+
+// CloudProjectQuotaRequest is the compute capacity a resource is about to consume in a region,
+// the RAM being in MB like the quotas
+type CloudProjectQuotaRequest struct {
+	Instances int
+	Cores     int
+	Ram       int
+}
+
+// Exceeded returns a message for each quota of the region the request doesn't fit in
+func (r CloudProjectQuotaRequest) Exceeded(quota *CloudProjectQuotaResponse) []string {
+	if quota == nil || quota.Instance == nil {
+		return nil
+	}
+
+	var exceeded []string
+	check := func(name string, requested, used, limit int) {
+		if requested <= 0 || used+requested <= limit {
+			return
+		}
+		left := limit - used
+		if left < 0 {
+			left = 0
+		}
+		exceeded = append(exceeded, fmt.Sprintf(
+			"%d %s requested in region %s but only %d left (%d/%d used)",
+			requested, name, quota.Region, left, used, limit,
+		))
+	}
+	check("instances", r.Instances, quota.Instance.UsedInstances, quota.Instance.MaxInstances)
+	check("cores", r.Cores, quota.Instance.UsedCores, quota.Instance.MaxCores)
+	check("MB of RAM", r.Ram, quota.Instance.UsedRam, quota.Instance.MaxRam)
+	return exceeded
+}
+
+type CloudProjectUsagePeriod struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type CloudProjectUsageResourcesUsage struct {
+	Type       string  `json:"type"`
+	TotalPrice float64 `json:"totalPrice"`
+}
+
+type CloudProjectUsageResponse struct {
+	LastUpdate     string                            `json:"lastUpdate"`
+	Period         CloudProjectUsagePeriod           `json:"period"`
+	ResourcesUsage []CloudProjectUsageResourcesUsage `json:"resourcesUsage"`
+}
+
+// TotalPrice returns the price of all the resources of the period
+func (v CloudProjectUsageResponse) TotalPrice() float64 {
+	total := 0.0
+	for _, usage := range v.ResourcesUsage {
+		total += usage.TotalPrice
+	}
+	return total
+}
+
+func (v CloudProjectUsageResponse) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["last_update"] = v.LastUpdate
+	obj["period_from"] = v.Period.From
+	obj["period_to"] = v.Period.To
+	obj["total_price"] = v.TotalPrice()
+
+	resourcesUsage := make([]map[string]interface{}, len(v.ResourcesUsage))
+	for i, usage := range v.ResourcesUsage {
+		resourcesUsage[i] = map[string]interface{}{
+			"type":        usage.Type,
+			"total_price": usage.TotalPrice,
+		}
+	}
+	obj["resources_usage"] = resourcesUsage
+
+	return obj
+}
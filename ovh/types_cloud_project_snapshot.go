@@ -0,0 +1,49 @@
+package ovh
+
+import (
+	"strings"
+	"unicode"
+)
+
+type CloudProjectSnapshotResponse struct {
+	Id           string  `json:"id"`
+	Name         string  `json:"name"`
+	Region       string  `json:"region"`
+	Status       string  `json:"status"`
+	CreationDate string  `json:"creationDate"`
+	MinDisk      int     `json:"minDisk"`
+	Size         float64 `json:"size"`
+	Type         string  `json:"type"`
+	Visibility   string  `json:"visibility"`
+}
+
+func (v CloudProjectSnapshotResponse) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["id"] = v.Id
+	obj["name"] = v.Name
+	obj["region"] = v.Region
+	obj["status"] = v.Status
+	obj["creation_date"] = v.CreationDate
+	obj["min_disk"] = v.MinDisk
+	obj["size"] = v.Size
+	return obj
+}
+
+// cloudProjectWorkflowBackupSnapshotMatch tells whether a snapshot was taken by a workflow:
+// the workflow names its snapshots after its backup name followed by the date
+func cloudProjectWorkflowBackupSnapshotMatch(backupName, snapshotName string) bool {
+	if backupName == "" || !strings.HasPrefix(snapshotName, backupName) {
+		return false
+	}
+	suffix := strings.TrimPrefix(snapshotName, backupName)
+	if suffix == "" {
+		return true
+	}
+	// "backup" must not match the snapshots of a "backup2" workflow
+	r := []rune(suffix)[0]
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+type CloudProjectInstanceReinstallOpts struct {
+	ImageId string `json:"imageId"`
+}
@@ -0,0 +1,36 @@
+package ovh
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+type CloudProjectSshKeyCreateOpts struct {
+	Name      string  `json:"name"`
+	PublicKey string  `json:"publicKey"`
+	Region    *string `json:"region,omitempty"`
+}
+
+func (opts *CloudProjectSshKeyCreateOpts) FromResource(d *schema.ResourceData) *CloudProjectSshKeyCreateOpts {
+	opts.Name = d.Get("name").(string)
+	opts.PublicKey = d.Get("public_key").(string)
+	opts.Region = helpers.GetNilStringPointerFromData(d, "region")
+	return opts
+}
+
+type CloudProjectSshKeyResponse struct {
+	Id          string   `json:"id"`
+	Name        string   `json:"name"`
+	PublicKey   string   `json:"publicKey"`
+	Fingerprint string   `json:"fingerprint"`
+	Regions     []string `json:"regions"`
+}
+
+func (v CloudProjectSshKeyResponse) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["name"] = v.Name
+	obj["public_key"] = v.PublicKey
+	obj["fingerprint"] = v.Fingerprint
+	obj["regions"] = v.Regions
+	return obj
+}
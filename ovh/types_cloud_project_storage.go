@@ -0,0 +1,422 @@
+package ovh
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+type CloudProjectStorageEncryption struct {
+	SseAlgorithm string `json:"sseAlgorithm"`
+}
+
+type CloudProjectStorageVersioning struct {
+	Status string `json:"status"`
+}
+
+type CloudProjectStorageObjectLockRule struct {
+	Mode   string `json:"mode"`
+	Period string `json:"period"`
+}
+
+type CloudProjectStorageObjectLock struct {
+	Status string                             `json:"status"`
+	Rule   *CloudProjectStorageObjectLockRule `json:"rule,omitempty"`
+}
+
+type CloudProjectStorageReplicationDestination struct {
+	Name                       string  `json:"name"`
+	Region                     string  `json:"region"`
+	StorageClass               *string `json:"storageClass,omitempty"`
+	RemoveOnMainBucketDeletion bool    `json:"removeOnMainBucketDeletion"`
+}
+
+type CloudProjectStorageRuleFilter struct {
+	Prefix                *string           `json:"prefix,omitempty"`
+	ObjectSizeGreaterThan *int              `json:"objectSizeGreaterThan,omitempty"`
+	ObjectSizeLessThan    *int              `json:"objectSizeLessThan,omitempty"`
+	Tags                  map[string]string `json:"tags,omitempty"`
+}
+
+type CloudProjectStorageReplicationRule struct {
+	Id                      string                                    `json:"id"`
+	Status                  string                                    `json:"status"`
+	Priority                int                                       `json:"priority"`
+	Destination             CloudProjectStorageReplicationDestination `json:"destination"`
+	Filter                  *CloudProjectStorageRuleFilter            `json:"filter,omitempty"`
+	DeleteMarkerReplication string                                    `json:"deleteMarkerReplication"`
+}
+
+type CloudProjectStorageReplication struct {
+	Rules []CloudProjectStorageReplicationRule `json:"rules"`
+}
+
+type CloudProjectStorageCreateOpts struct {
+	Name       string                         `json:"name"`
+	OwnerId    *int                           `json:"ownerId,omitempty"`
+	Encryption *CloudProjectStorageEncryption `json:"encryption,omitempty"`
+	Versioning *CloudProjectStorageVersioning `json:"versioning,omitempty"`
+	ObjectLock *CloudProjectStorageObjectLock `json:"objectLock,omitempty"`
+	Tags       map[string]string              `json:"tags,omitempty"`
+}
+
+func (opts *CloudProjectStorageCreateOpts) FromResource(d *schema.ResourceData) *CloudProjectStorageCreateOpts {
+	opts.Name = d.Get("name").(string)
+	opts.OwnerId = helpers.GetNilIntPointerFromData(d, "owner_id")
+	opts.Encryption = cloudProjectStorageEncryptionFromResource(d)
+	opts.Versioning = cloudProjectStorageVersioningFromResource(d)
+	opts.ObjectLock = cloudProjectStorageObjectLockFromResource(d)
+
+	tags := cloudProjectStorageTagsFromResource(d)
+	if len(tags) > 0 {
+		opts.Tags = tags
+	}
+
+	return opts
+}
+
+func (opts *CloudProjectStorageCreateOpts) String() string {
+	return fmt.Sprintf("name: %s, encryption: %+v, versioning: %+v, objectLock: %+v, tags: %v",
+		opts.Name, opts.Encryption, opts.Versioning, opts.ObjectLock, opts.Tags)
+}
+
+// CloudProjectStorageUpdateOpts only sends the given fields, the other
+// settings of the container are left untouched by the API
+type CloudProjectStorageUpdateOpts struct {
+	OwnerId     *int                            `json:"ownerId,omitempty"`
+	Encryption  *CloudProjectStorageEncryption  `json:"encryption,omitempty"`
+	Versioning  *CloudProjectStorageVersioning  `json:"versioning,omitempty"`
+	ObjectLock  *CloudProjectStorageObjectLock  `json:"objectLock,omitempty"`
+	Tags        *map[string]string              `json:"tags,omitempty"`
+	Replication *CloudProjectStorageReplication `json:"replication,omitempty"`
+}
+
+func (opts *CloudProjectStorageUpdateOpts) FromResource(d *schema.ResourceData) *CloudProjectStorageUpdateOpts {
+	if d.HasChange("owner_id") {
+		opts.OwnerId = helpers.GetNilIntPointerFromData(d, "owner_id")
+	}
+	if d.HasChange("encryption") {
+		opts.Encryption = cloudProjectStorageEncryptionFromResource(d)
+	}
+	if d.HasChange("versioning") {
+		opts.Versioning = cloudProjectStorageVersioningFromResource(d)
+	}
+	if d.HasChange("object_lock") {
+		opts.ObjectLock = cloudProjectStorageObjectLockFromResource(d)
+	}
+	if d.HasChange("tags") {
+		tags := cloudProjectStorageTagsFromResource(d)
+		opts.Tags = &tags
+	}
+
+	return opts
+}
+
+func cloudProjectStorageEncryptionFromResource(d *schema.ResourceData) *CloudProjectStorageEncryption {
+	if algorithm := helpers.GetNilStringPointerFromData(d, "encryption.0.sse_algorithm"); algorithm != nil {
+		return &CloudProjectStorageEncryption{SseAlgorithm: *algorithm}
+	}
+	return nil
+}
+
+func cloudProjectStorageVersioningFromResource(d *schema.ResourceData) *CloudProjectStorageVersioning {
+	if status := helpers.GetNilStringPointerFromData(d, "versioning.0.status"); status != nil {
+		return &CloudProjectStorageVersioning{Status: *status}
+	}
+	return nil
+}
+
+func cloudProjectStorageObjectLockFromResource(d *schema.ResourceData) *CloudProjectStorageObjectLock {
+	status := helpers.GetNilStringPointerFromData(d, "object_lock.0.status")
+	if status == nil {
+		return nil
+	}
+
+	objectLock := &CloudProjectStorageObjectLock{Status: *status}
+	if mode := helpers.GetNilStringPointerFromData(d, "object_lock.0.rule.0.mode"); mode != nil {
+		objectLock.Rule = &CloudProjectStorageObjectLockRule{
+			Mode:   *mode,
+			Period: d.Get("object_lock.0.rule.0.period").(string),
+		}
+	}
+	return objectLock
+}
+
+func cloudProjectStorageTagsFromResource(d *schema.ResourceData) map[string]string {
+	tags := make(map[string]string)
+	for k, v := range d.Get("tags").(map[string]interface{}) {
+		tags[k] = v.(string)
+	}
+	return tags
+}
+
+type CloudProjectStorageResponse struct {
+	Name         string                          `json:"name"`
+	Region       string                          `json:"region"`
+	CreatedAt    string                          `json:"createdAt"`
+	ObjectsCount int                             `json:"objectsCount"`
+	ObjectsSize  int                             `json:"objectsSize"`
+	OwnerId      int                             `json:"ownerId"`
+	VirtualHost  string                          `json:"virtualHost"`
+	Encryption   *CloudProjectStorageEncryption  `json:"encryption"`
+	Versioning   *CloudProjectStorageVersioning  `json:"versioning"`
+	ObjectLock   *CloudProjectStorageObjectLock  `json:"objectLock"`
+	Tags         map[string]string               `json:"tags"`
+	Replication  *CloudProjectStorageReplication `json:"replication"`
+}
+
+// ToMap returns the attributes of the container, except its replication
+// rules which are managed by ovh_cloud_project_storage_replication_configuration
+func (v CloudProjectStorageResponse) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["name"] = v.Name
+	obj["region_name"] = v.Region
+	obj["created_at"] = v.CreatedAt
+	obj["objects_count"] = v.ObjectsCount
+	obj["objects_size"] = v.ObjectsSize
+	obj["owner_id"] = v.OwnerId
+	obj["virtual_host"] = v.VirtualHost
+	obj["tags"] = v.Tags
+
+	if v.Encryption != nil {
+		obj["encryption"] = []map[string]interface{}{{"sse_algorithm": v.Encryption.SseAlgorithm}}
+	}
+	if v.Versioning != nil {
+		obj["versioning"] = []map[string]interface{}{{"status": v.Versioning.Status}}
+	}
+	if v.ObjectLock != nil {
+		objectLock := map[string]interface{}{"status": v.ObjectLock.Status}
+		if v.ObjectLock.Rule != nil {
+			objectLock["rule"] = []map[string]interface{}{{
+				"mode":   v.ObjectLock.Rule.Mode,
+				"period": v.ObjectLock.Rule.Period,
+			}}
+		}
+		obj["object_lock"] = []map[string]interface{}{objectLock}
+	}
+
+	return obj
+}
+
+// ReplicationRulesToMap returns the replication rules of the container
+func (v CloudProjectStorageResponse) ReplicationRulesToMap() []map[string]interface{} {
+	rules := make([]map[string]interface{}, 0)
+	if v.Replication == nil {
+		return rules
+	}
+
+	for _, rule := range v.Replication.Rules {
+		destination := map[string]interface{}{
+			"name":                           rule.Destination.Name,
+			"region":                         rule.Destination.Region,
+			"remove_on_main_bucket_deletion": rule.Destination.RemoveOnMainBucketDeletion,
+		}
+		if rule.Destination.StorageClass != nil {
+			destination["storage_class"] = *rule.Destination.StorageClass
+		}
+
+		obj := map[string]interface{}{
+			"id":                        rule.Id,
+			"status":                    rule.Status,
+			"priority":                  rule.Priority,
+			"delete_marker_replication": rule.DeleteMarkerReplication,
+			"destination":               []map[string]interface{}{destination},
+		}
+		if rule.Filter != nil {
+			obj["filter"] = []map[string]interface{}{rule.Filter.ToMap()}
+		}
+		rules = append(rules, obj)
+	}
+	return rules
+}
+
+func (v CloudProjectStorageRuleFilter) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	if v.Prefix != nil {
+		obj["prefix"] = *v.Prefix
+	}
+	if v.ObjectSizeGreaterThan != nil {
+		obj["object_size_greater_than"] = *v.ObjectSizeGreaterThan
+	}
+	if v.ObjectSizeLessThan != nil {
+		obj["object_size_less_than"] = *v.ObjectSizeLessThan
+	}
+	if len(v.Tags) > 0 {
+		obj["tags"] = v.Tags
+	}
+	return obj
+}
+
+// cloudProjectStorageRuleFilterFromMap returns the filter of a lifecycle or
+// replication rule, or nil when the rule applies to every object
+func cloudProjectStorageRuleFilterFromMap(filters []interface{}) *CloudProjectStorageRuleFilter {
+	if len(filters) == 0 || filters[0] == nil {
+		return nil
+	}
+	data := filters[0].(map[string]interface{})
+
+	filter := &CloudProjectStorageRuleFilter{
+		Prefix: helpers.GetNilStringPointerFromData(data, "prefix"),
+	}
+	if v, ok := data["object_size_greater_than"].(int); ok && v > 0 {
+		filter.ObjectSizeGreaterThan = &v
+	}
+	if v, ok := data["object_size_less_than"].(int); ok && v > 0 {
+		filter.ObjectSizeLessThan = &v
+	}
+	if tags, ok := data["tags"].(map[string]interface{}); ok && len(tags) > 0 {
+		filter.Tags = make(map[string]string)
+		for k, v := range tags {
+			filter.Tags[k] = v.(string)
+		}
+	}
+	return filter
+}
+
+func (opts *CloudProjectStorageReplication) FromResource(d *schema.ResourceData) *CloudProjectStorageReplication {
+	opts.Rules = []CloudProjectStorageReplicationRule{}
+
+	for _, r := range d.Get("rule").([]interface{}) {
+		data := r.(map[string]interface{})
+		destination := data["destination"].([]interface{})[0].(map[string]interface{})
+
+		opts.Rules = append(opts.Rules, CloudProjectStorageReplicationRule{
+			Id:       data["id"].(string),
+			Status:   data["status"].(string),
+			Priority: data["priority"].(int),
+			Destination: CloudProjectStorageReplicationDestination{
+				Name:                       destination["name"].(string),
+				Region:                     destination["region"].(string),
+				StorageClass:               helpers.GetNilStringPointerFromData(destination, "storage_class"),
+				RemoveOnMainBucketDeletion: destination["remove_on_main_bucket_deletion"].(bool),
+			},
+			Filter:                  cloudProjectStorageRuleFilterFromMap(data["filter"].([]interface{})),
+			DeleteMarkerReplication: data["delete_marker_replication"].(string),
+		})
+	}
+
+	return opts
+}
+
+type CloudProjectStorageLifecycleExpiration struct {
+	Days                      *int    `json:"days,omitempty"`
+	Date                      *string `json:"date,omitempty"`
+	ExpiredObjectDeleteMarker *bool   `json:"expiredObjectDeleteMarker,omitempty"`
+}
+
+type CloudProjectStorageLifecycleNoncurrentVersionExpiration struct {
+	NoncurrentDays          int  `json:"noncurrentDays"`
+	NewerNoncurrentVersions *int `json:"newerNoncurrentVersions,omitempty"`
+}
+
+type CloudProjectStorageLifecycleAbortIncompleteMultipartUpload struct {
+	DaysAfterInitiation int `json:"daysAfterInitiation"`
+}
+
+type CloudProjectStorageLifecycleRule struct {
+	Id                             string                                                      `json:"id"`
+	Status                         string                                                      `json:"status"`
+	Filter                         *CloudProjectStorageRuleFilter                              `json:"filter,omitempty"`
+	Expiration                     *CloudProjectStorageLifecycleExpiration                     `json:"expiration,omitempty"`
+	NoncurrentVersionExpiration    *CloudProjectStorageLifecycleNoncurrentVersionExpiration    `json:"noncurrentVersionExpiration,omitempty"`
+	AbortIncompleteMultipartUpload *CloudProjectStorageLifecycleAbortIncompleteMultipartUpload `json:"abortIncompleteMultipartUpload,omitempty"`
+}
+
+type CloudProjectStorageLifecycle struct {
+	Rules []CloudProjectStorageLifecycleRule `json:"rules"`
+}
+
+func (opts *CloudProjectStorageLifecycle) FromResource(d *schema.ResourceData) *CloudProjectStorageLifecycle {
+	opts.Rules = []CloudProjectStorageLifecycleRule{}
+
+	for _, r := range d.Get("rule").([]interface{}) {
+		data := r.(map[string]interface{})
+		rule := CloudProjectStorageLifecycleRule{
+			Id:     data["id"].(string),
+			Status: data["status"].(string),
+			Filter: cloudProjectStorageRuleFilterFromMap(data["filter"].([]interface{})),
+		}
+
+		if expirations := data["expiration"].([]interface{}); len(expirations) > 0 && expirations[0] != nil {
+			expiration := expirations[0].(map[string]interface{})
+			rule.Expiration = &CloudProjectStorageLifecycleExpiration{
+				Date: helpers.GetNilStringPointerFromData(expiration, "date"),
+			}
+			if days := expiration["days"].(int); days > 0 {
+				rule.Expiration.Days = &days
+			}
+			if expiration["expired_object_delete_marker"].(bool) {
+				rule.Expiration.ExpiredObjectDeleteMarker = helpers.GetNilBoolPointer(true)
+			}
+		}
+
+		if expirations := data["noncurrent_version_expiration"].([]interface{}); len(expirations) > 0 && expirations[0] != nil {
+			expiration := expirations[0].(map[string]interface{})
+			rule.NoncurrentVersionExpiration = &CloudProjectStorageLifecycleNoncurrentVersionExpiration{
+				NoncurrentDays: expiration["noncurrent_days"].(int),
+			}
+			if versions := expiration["newer_noncurrent_versions"].(int); versions > 0 {
+				rule.NoncurrentVersionExpiration.NewerNoncurrentVersions = &versions
+			}
+		}
+
+		if aborts := data["abort_incomplete_multipart_upload"].([]interface{}); len(aborts) > 0 && aborts[0] != nil {
+			rule.AbortIncompleteMultipartUpload = &CloudProjectStorageLifecycleAbortIncompleteMultipartUpload{
+				DaysAfterInitiation: aborts[0].(map[string]interface{})["days_after_initiation"].(int),
+			}
+		}
+
+		opts.Rules = append(opts.Rules, rule)
+	}
+
+	return opts
+}
+
+func (v CloudProjectStorageLifecycle) ToMap() []map[string]interface{} {
+	rules := make([]map[string]interface{}, 0, len(v.Rules))
+
+	for _, rule := range v.Rules {
+		obj := map[string]interface{}{
+			"id":     rule.Id,
+			"status": rule.Status,
+		}
+		if rule.Filter != nil {
+			obj["filter"] = []map[string]interface{}{rule.Filter.ToMap()}
+		}
+
+		if rule.Expiration != nil {
+			expiration := make(map[string]interface{})
+			if rule.Expiration.Days != nil {
+				expiration["days"] = *rule.Expiration.Days
+			}
+			if rule.Expiration.Date != nil {
+				expiration["date"] = *rule.Expiration.Date
+			}
+			if rule.Expiration.ExpiredObjectDeleteMarker != nil {
+				expiration["expired_object_delete_marker"] = *rule.Expiration.ExpiredObjectDeleteMarker
+			}
+			obj["expiration"] = []map[string]interface{}{expiration}
+		}
+
+		if rule.NoncurrentVersionExpiration != nil {
+			expiration := map[string]interface{}{
+				"noncurrent_days": rule.NoncurrentVersionExpiration.NoncurrentDays,
+			}
+			if rule.NoncurrentVersionExpiration.NewerNoncurrentVersions != nil {
+				expiration["newer_noncurrent_versions"] = *rule.NoncurrentVersionExpiration.NewerNoncurrentVersions
+			}
+			obj["noncurrent_version_expiration"] = []map[string]interface{}{expiration}
+		}
+
+		if rule.AbortIncompleteMultipartUpload != nil {
+			obj["abort_incomplete_multipart_upload"] = []map[string]interface{}{{
+				"days_after_initiation": rule.AbortIncompleteMultipartUpload.DaysAfterInitiation,
+			}}
+		}
+
+		rules = append(rules, obj)
+	}
+
+	return rules
+}
@@ -0,0 +1,116 @@
+package ovh
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+type CloudProjectVolumeCreateOpts struct {
+	Name             string  `json:"name"`
+	Description      *string `json:"description,omitempty"`
+	Size             int     `json:"size"`
+	Type             *string `json:"type,omitempty"`
+	ImageId          *string `json:"imageId,omitempty"`
+	SnapshotId       *string `json:"snapshotId,omitempty"`
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+}
+
+func (opts *CloudProjectVolumeCreateOpts) FromResource(d *schema.ResourceData) *CloudProjectVolumeCreateOpts {
+	opts.Name = d.Get("name").(string)
+	opts.Description = helpers.GetNilStringPointerFromData(d, "description")
+	opts.Size = d.Get("size").(int)
+	opts.Type = helpers.GetNilStringPointerFromData(d, "type")
+	opts.ImageId = helpers.GetNilStringPointerFromData(d, "image_id")
+	opts.SnapshotId = helpers.GetNilStringPointerFromData(d, "snapshot_id")
+	opts.AvailabilityZone = helpers.GetNilStringPointerFromData(d, "availability_zone")
+	return opts
+}
+
+func (opts *CloudProjectVolumeCreateOpts) String() string {
+	return fmt.Sprintf("name: %s, size: %d", opts.Name, opts.Size)
+}
+
+type CloudProjectVolumeUpdateOpts struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type CloudProjectVolumeUpsizeOpts struct {
+	Size int `json:"size"`
+}
+
+type CloudProjectVolumeAttachOpts struct {
+	InstanceId string `json:"instanceId"`
+}
+
+type CloudProjectVolumeResponse struct {
+	Id               string   `json:"id"`
+	Name             string   `json:"name"`
+	Description      string   `json:"description"`
+	Size             int      `json:"size"`
+	Type             string   `json:"type"`
+	Region           string   `json:"region"`
+	Status           string   `json:"status"`
+	Bootable         bool     `json:"bootable"`
+	CreationDate     string   `json:"creationDate"`
+	AttachedTo       []string `json:"attachedTo"`
+	AvailabilityZone string   `json:"availabilityZone"`
+}
+
+func (v CloudProjectVolumeResponse) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["id"] = v.Id
+	obj["name"] = v.Name
+	obj["description"] = v.Description
+	obj["size"] = v.Size
+	obj["type"] = v.Type
+	obj["region"] = v.Region
+	obj["status"] = v.Status
+	obj["bootable"] = v.Bootable
+	obj["created_at"] = v.CreationDate
+	obj["attached_to"] = v.AttachedTo
+	if v.AvailabilityZone != "" {
+		obj["availability_zone"] = v.AvailabilityZone
+	}
+	return obj
+}
+
+// IsAttachedTo returns true if the volume is attached to the instance
+func (v CloudProjectVolumeResponse) IsAttachedTo(instanceId string) bool {
+	for _, id := range v.AttachedTo {
+		if id == instanceId {
+			return true
+		}
+	}
+	return false
+}
+
+type CloudProjectVolumeSnapshotCreateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+type CloudProjectVolumeSnapshotResponse struct {
+	Id           string `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Size         int    `json:"size"`
+	Region       string `json:"region"`
+	Status       string `json:"status"`
+	VolumeId     string `json:"volumeId"`
+	CreationDate string `json:"creationDate"`
+}
+
+func (v CloudProjectVolumeSnapshotResponse) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["name"] = v.Name
+	obj["description"] = v.Description
+	obj["size"] = v.Size
+	obj["region"] = v.Region
+	obj["status"] = v.Status
+	obj["volume_id"] = v.VolumeId
+	obj["created_at"] = v.CreationDate
+	return obj
+}
@@ -11,6 +11,7 @@ type CloudProjectWorkflowBackupCreateOpts struct {
 	MaxExecutionCount *int64  `json:"maxExecutionCount,omitempty"`
 	Name              *string `json:"name"`
 	Rotation          *int64  `json:"rotation"`
+	BackupName        *string `json:"backupName,omitempty"`
 }
 
 type CloudProjectWorkflowBackupResponse struct {
@@ -28,6 +29,7 @@ func (opts *CloudProjectWorkflowBackupCreateOpts) FromResource(d *schema.Resourc
 	opts.MaxExecutionCount = helpers.GetNilInt64PointerFromData(d, "max_execution_count")
 	opts.Name = helpers.GetNilStringPointerFromData(d, "name")
 	opts.Rotation = helpers.GetNilInt64PointerFromData(d, "rotation")
+	opts.BackupName = helpers.GetNilStringPointerFromData(d, "backup_name")
 	return opts
 }
 